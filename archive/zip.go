@@ -111,6 +111,49 @@ func (z *Zip) AddDir(root, target string) error {
 	})
 }
 
+// AddFiles adds the files at `paths` relative to `root`, following
+// directory symlinks as returned by utils.LoadFiles.
+func (z *Zip) AddFiles(root string, paths []string) error {
+	for _, path := range paths {
+		fullPath := filepath.Join(root, path)
+
+		fh, err := os.Open(fullPath)
+		if err != nil {
+			return err
+		}
+
+		info, err := fh.Stat()
+		if err != nil {
+			fh.Close()
+			return err
+		}
+
+		if info.IsDir() {
+			// It's a symlink, otherwise it shouldn't be returned by LoadFiles
+			linkPath, err := filepath.EvalSymlinks(fullPath)
+			if err != nil {
+				fh.Close()
+				return err
+			}
+
+			err = z.AddDir(linkPath, path)
+		} else {
+			err = z.AddFile(path, fh)
+		}
+
+		if err != nil {
+			fh.Close()
+			return err
+		}
+
+		if err := fh.Close(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Close Zip writer.
 func (z *Zip) Close() error {
 	return z.writer.Close()
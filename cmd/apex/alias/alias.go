@@ -65,5 +65,13 @@ func run(c *cobra.Command, args []string) error {
 		return err
 	}
 
-	return root.Project.CreateOrUpdateAlias(alias, version)
+	if err := root.Project.CreateOrUpdateAlias(alias, version); err != nil {
+		return err
+	}
+
+	if root.JSON() {
+		return root.OutputReleases(alias)
+	}
+
+	return nil
 }
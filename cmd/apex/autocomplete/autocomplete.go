@@ -16,13 +16,17 @@ import (
 // accept function names as arguments.
 var funcCommands = map[string]bool{
 	"build":    true,
+	"cost":     true,
 	"delete":   true,
 	"deploy":   true,
+	"diff":     true,
+	"history":  true,
 	"invoke":   true,
 	"list":     true,
 	"logs":     true,
 	"metrics":  true,
 	"rollback": true,
+	"validate": true,
 }
 
 // Command config.
@@ -22,14 +22,32 @@ var envFile string
 // env supplied.
 var env []string
 
+// all functions built.
+var all bool
+
+// dist directory of --all builds.
+var dist string
+
+// concurrency of --all builds.
+var concurrency int
+
+// noCache disables the build cache.
+var noCache bool
+
 // example output.
 const example = `
     Build zip output for a function
-    $ apex build foo > /tmp/out.zip`
+    $ apex build foo > /tmp/out.zip
+
+    Build all functions concurrently into ./dist
+    $ apex build --all
+
+    Build all functions starting with "auth" into ./out
+    $ apex build --all --dist out auth*`
 
 // Command config.
 var Command = &cobra.Command{
-	Use:     "build <name>",
+	Use:     "build <name> | --all [<name>...]",
 	Short:   "Build a function",
 	Example: example,
 	PreRunE: preRun,
@@ -43,10 +61,18 @@ func init() {
 	f := Command.Flags()
 	f.StringVarP(&envFile, "env-file", "E", "", "Set environment variables from JSON file")
 	f.StringSliceVarP(&env, "set", "s", nil, "Set environment variable")
+	f.BoolVar(&all, "all", false, "Build all functions into the dist directory")
+	f.StringVar(&dist, "dist", "dist", "Output directory of --all")
+	f.IntVarP(&concurrency, "concurrency", "c", 5, "Concurrent builds of --all")
+	f.BoolVar(&noCache, "no-cache", false, "Disable the build cache enabled by cacheBuilds")
 }
 
 // PreRun errors if argument is missing.
 func preRun(c *cobra.Command, args []string) error {
+	if all {
+		return nil
+	}
+
 	if len(args) < 1 {
 		return errors.New("Missing name argument")
 	}
@@ -57,11 +83,19 @@ func preRun(c *cobra.Command, args []string) error {
 
 // Run command.
 func run(c *cobra.Command, args []string) error {
-	if err := root.Project.LoadFunctions(name); err != nil {
-		return err
+	root.Project.Concurrency = concurrency
+
+	if noCache {
+		root.Project.BuildCache = ""
 	}
 
-	fn := root.Project.Functions[0]
+	if !all {
+		args = []string{name}
+	}
+
+	if err := root.Project.LoadFunctions(args...); err != nil {
+		return err
+	}
 
 	if envFile != "" {
 		if err := root.Project.LoadEnvFromFile(envFile); err != nil {
@@ -78,6 +112,12 @@ func run(c *cobra.Command, args []string) error {
 		root.Project.Setenv(k, v)
 	}
 
+	if all {
+		return root.Project.Build(dist)
+	}
+
+	fn := root.Project.Functions[0]
+
 	zip, err := fn.Build()
 	if err != nil {
 		return err
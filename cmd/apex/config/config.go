@@ -0,0 +1,94 @@
+// Package config outputs the effective configuration of functions.
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/tj/cobra"
+
+	"github.com/apex/apex/cmd/apex/root"
+	"github.com/apex/apex/colors"
+	"github.com/apex/apex/function"
+)
+
+// example output.
+const example = `
+    Print the configuration of all functions
+    $ apex config show
+
+    Print the configuration of a function merged with its prod overlays
+    $ apex config show foo --env prod`
+
+// Command config.
+var Command = &cobra.Command{
+	Use:   "config",
+	Short: "Manage function configuration",
+}
+
+// show command config.
+var show = &cobra.Command{
+	Use:     "show [<name>...]",
+	Short:   "Output the effective configuration of functions",
+	Long:    "Output the configuration of functions after merging the project defaults, function.json and the environment's overlays.",
+	Example: example,
+	RunE:    run,
+}
+
+// Initialize.
+func init() {
+	root.Register(Command)
+	Command.AddCommand(show)
+}
+
+// record of a function's configuration.
+type record struct {
+	Name         string          `json:"name"`
+	FunctionName string          `json:"function_name"`
+	Region       string          `json:"region,omitempty"`
+	Config       function.Config `json:"config"`
+}
+
+// Run command.
+func run(c *cobra.Command, args []string) error {
+	if err := root.Project.LoadFunctions(args...); err != nil {
+		return err
+	}
+
+	if !root.JSON() {
+		fmt.Println()
+	}
+
+	for _, fn := range root.Project.Functions {
+		if root.JSON() {
+			err := root.Output(record{
+				Name:         fn.Name,
+				FunctionName: fn.FunctionName,
+				Region:       fn.Region,
+				Config:       fn.Config,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		b, err := json.MarshalIndent(fn.Config, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		name := fn.Name
+		if fn.Region != "" {
+			name = fmt.Sprintf("%s (%s)", fn.Name, fn.Region)
+		}
+
+		fmt.Printf("  \033[%dm%s\033[0m\n", colors.Blue, name)
+		fmt.Printf("    %s\n\n", strings.Replace(string(b), "\n", "\n    ", -1))
+	}
+
+	return nil
+}
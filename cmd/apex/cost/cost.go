@@ -0,0 +1,247 @@
+// Package cost outputs the projected monthly cost of functions.
+package cost
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/dustin/go-humanize"
+	"github.com/tj/cobra"
+
+	"github.com/apex/apex/cmd/apex/root"
+	"github.com/apex/apex/colors"
+	"github.com/apex/apex/cost"
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/metrics"
+)
+
+// month projected to.
+const month = 30 * 24 * time.Hour
+
+// duration of metrics projected.
+var duration time.Duration
+
+// freeTier deduction.
+var freeTier bool
+
+// example output.
+const example = `
+    Project the monthly cost of all functions from the last week
+    $ apex cost
+
+    Project the monthly cost of a function from the last day
+    $ apex cost foo --since 24h
+
+    Project the monthly cost with the free tier deducted
+    $ apex cost --free-tier`
+
+// Command config.
+var Command = &cobra.Command{
+	Use:     "cost [<name>...]",
+	Short:   "Output projected monthly cost of functions",
+	Long:    "Output the monthly cost of functions projected from their recent invocations, memory, architecture and provisioned concurrency.",
+	Example: example,
+	RunE:    run,
+}
+
+// Initialize.
+func init() {
+	root.Register(Command)
+
+	f := Command.Flags()
+	f.DurationVarP(&duration, "since", "s", 7*24*time.Hour, "Period of metrics projected")
+	f.BoolVar(&freeTier, "free-tier", false, "Deduct the monthly free tier")
+}
+
+// Run command.
+func run(c *cobra.Command, args []string) error {
+	if err := root.Project.LoadFunctions(args...); err != nil {
+		return err
+	}
+
+	if duration <= 0 {
+		return fmt.Errorf("--since must be positive")
+	}
+
+	scale := float64(month) / float64(duration)
+
+	config := metrics.Config{
+		StartDate:   time.Now().UTC().Add(-duration),
+		EndDate:     time.Now().UTC(),
+		MetricNames: []string{"Invocations", "Duration"},
+	}
+
+	// usage is collected and priced in the region of each function
+	var records []record
+	var requests int
+	var gbSeconds float64
+	regions := make(map[string]*clients)
+
+	for _, fn := range root.Project.Functions {
+		region, c := regional(regions, fn)
+
+		m := metrics.Metrics{
+			Config:        config,
+			FunctionNames: []string{fn.FunctionName},
+		}
+
+		m.Service = c.CloudWatch
+
+		aggregated, err := m.Collect()
+		if err != nil {
+			return err
+		}
+
+		a := aggregated[fn.FunctionName]
+
+		conf, err := c.Lambda.GetFunctionConfiguration(&lambda.GetFunctionConfigurationInput{FunctionName: &fn.FunctionName})
+		if err != nil {
+			return err
+		}
+
+		u := cost.Usage{
+			Requests:     int(float64(a.Invocations) * scale),
+			Duration:     int(float64(a.Duration) * scale),
+			Memory:       int(aws.Int64Value(conf.MemorySize)),
+			Architecture: function.RemoteArchitecture(conf),
+			Period:       month,
+		}
+
+		if fn.ProvisionedConcurrency != nil {
+			u.ProvisionedConcurrency = int(*fn.ProvisionedConcurrency)
+		}
+
+		bill := cost.PricesFor(region).Bill(u)
+		requests += u.Requests
+		gbSeconds += bill.GBSeconds
+
+		records = append(records, record{
+			Name:                   fn.Name,
+			FunctionName:           fn.FunctionName,
+			Region:                 region,
+			Memory:                 u.Memory,
+			Architecture:           u.Architecture,
+			ProvisionedConcurrency: u.ProvisionedConcurrency,
+			Invocations:            u.Requests,
+			Duration:               u.Duration,
+			GBSeconds:              bill.GBSeconds,
+			Cost: costs{
+				Requests:    bill.Requests,
+				Duration:    bill.Duration,
+				Provisioned: bill.Provisioned,
+				Total:       bill.Total(),
+			},
+			bill: bill,
+		})
+	}
+
+	if freeTier {
+		for i, r := range records {
+			records[i].Cost.FreeTier = cost.FreeTierDiscount(r.bill, requests, gbSeconds)
+			records[i].Cost.Total -= records[i].Cost.FreeTier
+		}
+	}
+
+	if root.JSON() {
+		for _, r := range records {
+			if err := root.Output(r); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var total float64
+
+	fmt.Println()
+	for _, r := range records {
+		total += r.Cost.Total
+
+		fmt.Printf("  \033[%dm%s\033[0m \033[%dm%s\033[0m\n", colors.Blue, r.Name, colors.Gray, r.Region)
+		fmt.Printf("    total cost: $%s/month\n", usd(r.Cost.Total))
+		fmt.Printf("    invocations: %s ($%s)\n", humanize.Comma(int64(r.Invocations)), usd(r.Cost.Requests))
+		fmt.Printf("    duration: %s GB-s ($%s)\n", humanize.Comma(int64(r.GBSeconds)), usd(r.Cost.Duration))
+
+		if r.ProvisionedConcurrency > 0 {
+			fmt.Printf("    provisioned concurrency: %d ($%s)\n", r.ProvisionedConcurrency, usd(r.Cost.Provisioned))
+		}
+
+		if freeTier {
+			fmt.Printf("    free tier: -$%s\n", usd(r.Cost.FreeTier))
+		}
+
+		fmt.Printf("    memory: %d\n", r.Memory)
+		fmt.Printf("    architecture: %s\n", r.Architecture)
+		fmt.Println()
+	}
+
+	fmt.Printf("  total cost: $%s/month \033[%dm(projected from %s)\033[0m\n", usd(total), colors.Gray, duration)
+	fmt.Println()
+
+	return nil
+}
+
+// clients of a region.
+type clients struct {
+	Lambda     lambdaiface.LambdaAPI
+	CloudWatch cloudwatchiface.CloudWatchAPI
+}
+
+// regional returns the region of `fn`, defaulting to the region of the
+// session, and its clients, which are shared by functions of the region.
+func regional(regions map[string]*clients, fn *function.Function) (string, *clients) {
+	config := fn.AWSConfig()
+	if config == nil {
+		config = aws.NewConfig()
+	}
+
+	region := aws.StringValue(config.Region)
+	if region == "" {
+		region = aws.StringValue(root.Session.Config.Region)
+	}
+
+	if _, ok := regions[region]; !ok {
+		regions[region] = &clients{
+			Lambda:     lambda.New(root.Session, config),
+			CloudWatch: cloudwatch.New(root.Session, config),
+		}
+	}
+
+	return region, regions[region]
+}
+
+// usd returns `v` dollars rounded to cents.
+func usd(v float64) string {
+	return humanize.FormatFloat("#,###.##", v)
+}
+
+// record of the projected monthly cost of a function, durations in milliseconds.
+type record struct {
+	Name                   string  `json:"name"`
+	FunctionName           string  `json:"function_name"`
+	Region                 string  `json:"region"`
+	Memory                 int     `json:"memory"`
+	Architecture           string  `json:"architecture"`
+	ProvisionedConcurrency int     `json:"provisioned_concurrency"`
+	Invocations            int     `json:"invocations"`
+	Duration               int     `json:"duration"`
+	GBSeconds              float64 `json:"gb_seconds"`
+	Cost                   costs   `json:"cost"`
+
+	bill cost.Bill
+}
+
+// costs in USD per month.
+type costs struct {
+	Requests    float64 `json:"requests"`
+	Duration    float64 `json:"duration"`
+	Provisioned float64 `json:"provisioned"`
+	FreeTier    float64 `json:"free_tier,omitempty"`
+	Total       float64 `json:"total"`
+}
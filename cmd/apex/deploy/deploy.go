@@ -3,10 +3,14 @@ package deploy
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/tj/cobra"
 
 	"github.com/apex/apex/cmd/apex/root"
+	"github.com/apex/apex/function"
 	"github.com/apex/apex/utils"
 )
 
@@ -25,6 +29,21 @@ var alias string
 // zip path.
 var zip string
 
+// canary traffic percentage.
+var canary string
+
+// canary bake window.
+var canaryBake time.Duration
+
+// canary errors and throttles tolerated.
+var canaryThreshold int
+
+// canaryRequireInvocations reverts canaries which are not invoked.
+var canaryRequireInvocations bool
+
+// noCache disables the build cache.
+var noCache bool
+
 // example output.
 const example = `
     Deploy all functions
@@ -36,6 +55,9 @@ const example = `
     Deploy canary alias
     $ apex deploy foo --alias canary
 
+    Route 10% of traffic to the new version for 10 minutes before promoting it
+    $ apex deploy foo --canary 10% --canary-bake 10m
+
     Deploy functions in a different project
     $ apex deploy -C ~/dev/myapp
 
@@ -63,6 +85,11 @@ func init() {
 	f.StringVarP(&alias, "alias", "a", "current", "Function alias")
 	f.StringVarP(&zip, "zip", "z", "", "Zip path")
 	f.IntVarP(&concurrency, "concurrency", "c", 5, "Concurrent deploys")
+	f.StringVar(&canary, "canary", "", "Route a percentage of traffic to the new version")
+	f.DurationVar(&canaryBake, "canary-bake", 5*time.Minute, "Canary bake window before promotion")
+	f.IntVar(&canaryThreshold, "canary-threshold", 0, "Canary errors and throttles tolerated")
+	f.BoolVar(&canaryRequireInvocations, "canary-require-invocations", false, "Revert canaries which are not invoked during the bake")
+	f.BoolVar(&noCache, "no-cache", false, "Disable the build cache enabled by cacheBuilds")
 }
 
 // Run command.
@@ -71,6 +98,24 @@ func run(c *cobra.Command, args []string) error {
 	root.Project.Alias = alias
 	root.Project.Zip = zip
 
+	if noCache {
+		root.Project.BuildCache = ""
+	}
+
+	if canary != "" {
+		weight, err := parseWeight(canary)
+		if err != nil {
+			return err
+		}
+
+		root.Project.Canary = &function.Canary{
+			Weight:             weight,
+			Bake:               canaryBake,
+			Threshold:          canaryThreshold,
+			RequireInvocations: canaryRequireInvocations,
+		}
+	}
+
 	if err := root.Project.LoadFunctions(args...); err != nil {
 		return err
 	}
@@ -90,5 +135,23 @@ func run(c *cobra.Command, args []string) error {
 		root.Project.Setenv(k, v)
 	}
 
-	return root.Project.DeployAndClean()
+	if err := root.Project.DeployAndClean(); err != nil {
+		return err
+	}
+
+	if root.JSON() {
+		return root.OutputReleases(alias)
+	}
+
+	return nil
+}
+
+// parseWeight parses a traffic percentage such as "10%" into a weight.
+func parseWeight(s string) (float64, error) {
+	n, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+	if err != nil || n <= 0 || n >= 100 {
+		return 0, fmt.Errorf("invalid canary percentage %q", s)
+	}
+
+	return n / 100, nil
 }
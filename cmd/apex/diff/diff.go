@@ -0,0 +1,136 @@
+// Package diff outputs the differences between local and deployed functions.
+package diff
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/tj/cobra"
+
+	"github.com/apex/apex/cmd/apex/root"
+	"github.com/apex/apex/colors"
+	"github.com/apex/apex/function"
+)
+
+// jsonOutput enabled, an alias of --output json.
+var jsonOutput bool
+
+// exitCode on drift.
+var exitCode bool
+
+// example output.
+const example = `
+    Diff all functions
+    $ apex diff
+
+    Diff specific functions
+    $ apex diff foo bar
+
+    Output the diff as JSON
+    $ apex diff --json
+
+    Exit with status 2 when functions have drifted, useful in CI
+    $ apex diff --exit-code`
+
+// Command config.
+var Command = &cobra.Command{
+	Use:     "diff [<name>...]",
+	Short:   "Output differences between local and deployed functions",
+	Example: example,
+	RunE:    run,
+}
+
+// Initialize.
+func init() {
+	root.Register(Command)
+
+	f := Command.Flags()
+	f.BoolVar(&jsonOutput, "json", false, "Output as JSON, same as --output json")
+	f.BoolVar(&exitCode, "exit-code", false, "Exit with status 2 when functions have drifted")
+}
+
+// Run command.
+func run(c *cobra.Command, args []string) error {
+	if err := root.Project.LoadFunctions(args...); err != nil {
+		return err
+	}
+
+	diffs, err := root.Project.Diff()
+	if err != nil {
+		return err
+	}
+
+	if err := root.Project.Clean(); err != nil {
+		return err
+	}
+
+	if jsonOutput {
+		root.SetJSON()
+	}
+
+	if root.JSON() {
+		for _, d := range diffs {
+			if err := root.Output(d); err != nil {
+				return err
+			}
+		}
+	} else {
+		outputDiff(diffs)
+	}
+
+	if exitCode && drift(diffs) {
+		os.Exit(2)
+	}
+
+	return nil
+}
+
+// outputDiff format.
+func outputDiff(diffs []*function.Diff) {
+	fmt.Println()
+	for _, d := range diffs {
+		switch {
+		case d.Created:
+			fmt.Printf("  \033[%dm+ function\033[0m \033[%dm%s\033[0m (not deployed)\n", colors.Green, colors.Blue, name(d))
+		case d.Drift():
+			fmt.Printf("  \033[%dm~ function\033[0m \033[%dm%s\033[0m\n", colors.Yellow, colors.Blue, name(d))
+		default:
+			fmt.Printf("  \033[%dm%s\033[0m (unchanged)\n", colors.Blue, name(d))
+		}
+
+		for _, c := range d.Changes {
+			fmt.Printf("    %s: %s -> %s\n", c.Field, value(c.Remote), value(c.Local))
+		}
+
+		fmt.Println()
+	}
+}
+
+// name returns the function name, with the region when specified.
+func name(d *function.Diff) string {
+	if d.Region == "" {
+		return d.Name
+	}
+
+	return fmt.Sprintf("%s (%s)", d.Name, d.Region)
+}
+
+// value returns `s` or a placeholder when empty.
+func value(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+
+	return s
+}
+
+// drift returns true if any function has drifted.
+func drift(diffs []*function.Diff) bool {
+	for _, d := range diffs {
+		if d.Drift() {
+			return true
+		}
+	}
+
+	return false
+}
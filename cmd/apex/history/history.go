@@ -0,0 +1,151 @@
+// Package history outputs the deploy history of functions.
+package history
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/tj/cobra"
+
+	"github.com/apex/apex/cmd/apex/root"
+	"github.com/apex/apex/colors"
+	"github.com/apex/apex/function"
+)
+
+// example output.
+const example = `
+    Print the deploy history of all functions
+    $ apex history
+
+    Print the deploy history of a function
+    $ apex history foo
+
+    Rollback a function to the version deployed from a commit
+    $ apex rollback foo --to 3f2c1a9`
+
+// Command config.
+var Command = &cobra.Command{
+	Use:     "history [<name>...]",
+	Short:   "Output deploy history of functions",
+	Example: example,
+	RunE:    run,
+}
+
+// Initialize.
+func init() {
+	root.Register(Command)
+}
+
+// record of a release.
+type record struct {
+	Name         string `json:"name"`
+	FunctionName string `json:"function_name"`
+	Active       bool   `json:"active"`
+	*function.Release
+}
+
+// Run command.
+func run(c *cobra.Command, args []string) error {
+	if err := root.Project.LoadFunctions(args...); err != nil {
+		return err
+	}
+
+	if !root.JSON() {
+		fmt.Println()
+	}
+
+	for _, fn := range root.Project.Functions {
+		releases, err := fn.History()
+		if err != nil {
+			return fmt.Errorf("function %s: %s", fn.Name, err)
+		}
+
+		aliases, err := fn.GetAliases()
+		if err != nil {
+			return fmt.Errorf("function %s: %s", fn.Name, err)
+		}
+
+		current := make(map[string]string)
+		for _, a := range aliases.Aliases {
+			current[*a.Name] = *a.FunctionVersion
+		}
+
+		if root.JSON() {
+			for _, r := range releases {
+				err := root.Output(record{
+					Name:         fn.Name,
+					FunctionName: fn.FunctionName,
+					Active:       current[r.Alias] == r.Version,
+					Release:      r,
+				})
+
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		output(fn, releases, current)
+	}
+
+	return nil
+}
+
+// output the releases of `fn` grouped by alias.
+func output(fn *function.Function, releases []*function.Release, current map[string]string) {
+	fmt.Printf("  \033[%dm%s\033[0m\n", colors.Blue, fn.Name)
+
+	if len(releases) == 0 {
+		fmt.Printf("    <none>\n\n")
+		return
+	}
+
+	byAlias := make(map[string][]*function.Release)
+	for _, r := range releases {
+		byAlias[r.Alias] = append(byAlias[r.Alias], r)
+	}
+
+	var names []string
+	for name := range byAlias {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if v, ok := current[name]; ok {
+			fmt.Printf("    %s@v%s\n", name, v)
+		} else {
+			fmt.Printf("    %s\n", name)
+		}
+
+		for _, r := range byAlias[name] {
+			marker := " "
+			if current[name] == r.Version {
+				marker = "*"
+			}
+
+			fmt.Printf("    %s v%-4s %s  %-7s  %s  %s\n", marker, r.Version, r.Time.Local().Format("2006-01-02 15:04:05"), short(r.Commit), value(r.User), value(r.Environment))
+		}
+	}
+
+	fmt.Println()
+}
+
+// short returns the abbreviated commit.
+func short(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+
+	return value(commit)
+}
+
+// value returns `s` or a placeholder when empty.
+func value(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
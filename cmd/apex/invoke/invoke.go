@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/tj/cobra"
 
 	"github.com/apex/apex/cmd/apex/root"
+	"github.com/apex/apex/function"
 )
 
 // alias.
@@ -24,13 +26,19 @@ var includeLogs bool
 // name of function.
 var name string
 
+// local invocation.
+var local bool
+
 // example output.
 const example = `
     Invoke a function with input json
     $ apex invoke foo < request.json
 
     Invoke canary alias
-    $ apex invoke foo < request.json --alias canary`
+    $ apex invoke foo < request.json --alias canary
+
+    Invoke the function locally without deploying it
+    $ apex invoke foo < request.json --local`
 
 // Command config.
 var Command = &cobra.Command{
@@ -48,6 +56,7 @@ func init() {
 	f := Command.Flags()
 	f.BoolVarP(&includeLogs, "logs", "L", false, "Print logs")
 	f.StringVarP(&alias, "alias", "a", "current", "Function alias")
+	f.BoolVar(&local, "local", false, "Invoke the function locally")
 }
 
 // PreRun errors if the name argument is missing.
@@ -71,6 +80,17 @@ func run(c *cobra.Command, args []string) error {
 	}
 
 	fn := root.Project.Functions[0]
+	invoke := fn.Invoke
+
+	if local {
+		l, err := fn.Local()
+		if err != nil {
+			return err
+		}
+		defer l.Close()
+
+		invoke = l.Invoke
+	}
 
 	for {
 		var v map[string]interface{}
@@ -87,9 +107,16 @@ func run(c *cobra.Command, args []string) error {
 		var reply, logs io.Reader
 
 		if e, ok := v["event"].(map[string]interface{}); ok {
-			reply, logs, err = fn.Invoke(e, v["context"])
+			reply, logs, err = invoke(e, v["context"])
 		} else {
-			reply, logs, err = fn.Invoke(v, nil)
+			reply, logs, err = invoke(v, nil)
+		}
+
+		if root.JSON() {
+			if err := output(fn.Name, reply, logs, err); err != nil {
+				return err
+			}
+			continue
 		}
 
 		if includeLogs && logs != nil {
@@ -107,6 +134,56 @@ func run(c *cobra.Command, args []string) error {
 	return nil
 }
 
+// record of an invocation.
+type record struct {
+	Name     string          `json:"name"`
+	Alias    string          `json:"alias"`
+	Local    bool            `json:"local"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    interface{}     `json:"error,omitempty"`
+	Logs     string          `json:"logs,omitempty"`
+}
+
+// output the invocation as a JSON record, function errors are
+// included in the record, other errors are returned.
+func output(name string, reply, logs io.Reader, err error) error {
+	r := record{
+		Name:  name,
+		Alias: alias,
+		Local: local,
+	}
+
+	if e, ok := err.(*function.InvokeError); ok {
+		r.Error = e
+	} else if err != nil {
+		return fmt.Errorf("function response: %s", err)
+	}
+
+	if reply != nil {
+		b, err := ioutil.ReadAll(reply)
+		if err != nil {
+			return err
+		}
+
+		r.Response = b
+
+		if !json.Valid(b) {
+			r.Response, _ = json.Marshal(string(b))
+		}
+	}
+
+	if includeLogs && logs != nil {
+		b, err := ioutil.ReadAll(logs)
+		if err != nil {
+			return err
+		}
+
+		r.Logs = string(b)
+	}
+
+	return root.Output(r)
+}
+
 // input from stdin or empty object by default.
 func input() io.Reader {
 	if isatty.IsTerminal(os.Stdin.Fd()) {
@@ -3,6 +3,7 @@ package list
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/apex/log"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -48,15 +49,102 @@ func run(c *cobra.Command, args []string) error {
 		return err
 	}
 
-	if tfvars {
+	switch {
+	case tfvars:
 		outputTFvars()
-	} else {
+	case root.JSON():
+		return outputJSON()
+	default:
 		outputList()
 	}
 
 	return nil
 }
 
+// record of a function.
+type record struct {
+	Name         string   `json:"name"`
+	FunctionName string   `json:"function_name"`
+	Region       string   `json:"region,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Runtime      string   `json:"runtime"`
+	Memory       int64    `json:"memory"`
+	Timeout      int64    `json:"timeout"`
+	Role         string   `json:"role"`
+	Handler      string   `json:"handler"`
+	Deployed     bool     `json:"deployed"`
+	ARN          string   `json:"arn,omitempty"`
+	Version      string   `json:"version,omitempty"`
+	Aliases      []alias  `json:"aliases"`
+	Versions     []string `json:"versions"`
+}
+
+// alias of a function.
+type alias struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// outputJSON format.
+func outputJSON() error {
+	for _, fn := range root.Project.Functions {
+		r := record{
+			Name:         fn.Name,
+			FunctionName: fn.FunctionName,
+			Region:       fn.Region,
+			Description:  fn.Description,
+			Runtime:      fn.Runtime,
+			Memory:       fn.Memory,
+			Timeout:      fn.Timeout,
+			Role:         fn.Role,
+			Handler:      fn.Handler,
+			Aliases:      []alias{},
+			Versions:     []string{},
+		}
+
+		config, err := fn.GetConfigCurrent()
+
+		if e, ok := err.(awserr.Error); ok && e.Code() == "ResourceNotFoundException" {
+			if err := root.Output(r); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		r.Deployed = true
+		r.ARN = *config.Configuration.FunctionArn
+		r.Version = *config.Configuration.Version
+
+		aliases, err := fn.GetAliases()
+		if err != nil {
+			return err
+		}
+
+		for _, a := range aliases.Aliases {
+			r.Aliases = append(r.Aliases, alias{Name: *a.Name, Version: *a.FunctionVersion})
+		}
+
+		versions, err := fn.Versions()
+		if err != nil {
+			return err
+		}
+
+		for _, v := range versions {
+			r.Versions = append(r.Versions, *v.Version)
+		}
+
+		if err := root.Output(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // outputTFvars format.
 func outputTFvars() {
 	for _, fn := range root.Project.Functions {
@@ -66,7 +154,12 @@ func outputTFvars() {
 			continue
 		}
 
-		fmt.Printf("apex_function_%s=%q\n", fn.Name, *config.Configuration.FunctionArn)
+		name := fn.Name
+		if len(fn.Regions) > 0 {
+			name += "_" + strings.Replace(fn.Region, "-", "_", -1)
+		}
+
+		fmt.Printf("apex_function_%s=%q\n", name, *config.Configuration.FunctionArn)
 	}
 }
 
@@ -82,6 +175,9 @@ func outputList() {
 			fmt.Printf("  \033[%dm%s\033[0m\n", colors.Blue, fn.Name)
 		}
 
+		if fn.Region != "" {
+			fmt.Printf("    region: %v\n", fn.Region)
+		}
 		if fn.Description != "" {
 			fmt.Printf("    description: %v\n", fn.Description)
 		}
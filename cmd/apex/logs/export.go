@@ -0,0 +1,211 @@
+package logs
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/tj/cobra"
+
+	"github.com/apex/apex/cmd/apex/root"
+	"github.com/apex/apex/logs"
+)
+
+// checkpointEvery is the number of events exported between checkpoints.
+const checkpointEvery = 1000
+
+// export flags.
+var (
+	exportSince      time.Duration
+	exportStart      string
+	exportEnd        string
+	exportFile       string
+	exportCheckpoint string
+)
+
+// exportExample output.
+const exportExample = `
+    Export the last day of logs of all functions to a file
+    $ apex logs export --since 24h --file logs.ndjson
+
+    Export logs between absolute times
+    $ apex logs export api --start 2024-03-01 --end 2024-03-02 --file api.ndjson
+
+    Export new logs since the previous run, for example from cron
+    $ apex logs export --file logs.ndjson --checkpoint logs.checkpoint`
+
+// exportCommand config.
+var exportCommand = &cobra.Command{
+	Use:     "export [<name>...]",
+	Short:   "Export function logs as NDJSON",
+	Long:    "Export function logs as newline delimited JSON records. With --checkpoint the position of each log group is recorded, and following exports resume from it whatever the start time, without gaps or duplicates.",
+	Example: exportExample,
+	RunE:    runExport,
+}
+
+// Initialize.
+func init() {
+	Command.AddCommand(exportCommand)
+
+	f := exportCommand.Flags()
+	f.DurationVarP(&exportSince, "since", "s", 5*time.Minute, "Start time of the export of groups without a checkpoint")
+	f.StringVar(&exportStart, "start", "", "Start time of the export of groups without a checkpoint, overriding --since")
+	f.StringVar(&exportEnd, "end", "", "End time of the export, now by default")
+	f.StringVar(&exportFile, "file", "", "File appended to, stdout by default")
+	f.StringVar(&exportCheckpoint, "checkpoint", "", "Checkpoint file to resume from and update")
+}
+
+// Run export command.
+func runExport(c *cobra.Command, args []string) error {
+	if err := root.Project.LoadFunctions(args...); err != nil {
+		return err
+	}
+
+	start, end, err := timeRange(exportSince, exportStart, exportEnd)
+	if err != nil {
+		return err
+	}
+
+	// bound the export so that it completes, the next export resumes from there
+	if end.IsZero() {
+		end = time.Now().UTC()
+	}
+
+	checkpoint := &logs.Checkpoint{Groups: make(map[string]*logs.Position)}
+	var resume bool
+
+	if exportCheckpoint != "" {
+		if _, err := os.Stat(exportCheckpoint); err == nil {
+			resume = true
+		}
+
+		if checkpoint, err = logs.LoadCheckpoint(exportCheckpoint); err != nil {
+			return fmt.Errorf("loading checkpoint: %s", err)
+		}
+	}
+
+	file, err := openExport(exportFile, checkpoint.Offset, resume)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	l := &logs.Logs{
+		Config: logs.Config{
+			Service:   cloudwatchlogs.New(root.Session),
+			StartTime: start,
+			EndTime:   end,
+		},
+		Groups: groups(),
+	}
+
+	for i := range l.Groups {
+		l.Groups[i].Position = checkpoint.Position(l.Groups[i])
+	}
+
+	w := bufio.NewWriter(file)
+	enc := json.NewEncoder(w)
+
+	// save flushes the exported events, then records their position
+	save := func() error {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		if exportCheckpoint == "" {
+			return nil
+		}
+
+		if exportFile != "" {
+			if err := file.Sync(); err != nil {
+				return err
+			}
+
+			offset, err := file.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return err
+			}
+
+			checkpoint.Offset = offset
+		}
+
+		return checkpoint.Save(exportCheckpoint)
+	}
+
+	// record the offset before exporting, in case the first checkpoint is never reached
+	if err := save(); err != nil {
+		return err
+	}
+
+	var n int
+	for event := range l.Start() {
+		if err := enc.Encode(newRecord(event)); err != nil {
+			return err
+		}
+
+		checkpoint.Add(event)
+		n++
+
+		if n%checkpointEvery == 0 {
+			if err := save(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := save(); err != nil {
+		return err
+	}
+
+	if err := l.Err(); err != nil {
+		return err
+	}
+
+	root.Project.Log.Debugf("exported %d events", n)
+	return nil
+}
+
+// openExport opens the export file at `path`, or stdout. When resuming the file is
+// truncated to the checkpoint's `offset`, removing the events written after it.
+func openExport(path string, offset int64, resume bool) (*os.File, error) {
+	if path == "" {
+		return os.Stdout, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if !resume {
+		offset = info.Size()
+	}
+
+	if offset > info.Size() {
+		f.Close()
+		return nil, errors.New("export file is smaller than recorded by the checkpoint")
+	}
+
+	if err := f.Truncate(offset); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return f, nil
+}
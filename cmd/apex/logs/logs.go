@@ -2,13 +2,18 @@
 package logs
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
 	"github.com/tj/cobra"
 
 	"github.com/apex/apex/cmd/apex/root"
+	"github.com/apex/apex/colors"
 	"github.com/apex/apex/logs"
 )
 
@@ -21,6 +26,24 @@ var follow bool
 // duration of results.
 var duration time.Duration
 
+// start and end times of results.
+var startTime, endTime string
+
+// level is the minimum level of events.
+var level string
+
+// where filters by JSON fields.
+var where []string
+
+// requests groups logs by invocation.
+var requests bool
+
+// failed invocations only.
+var failed bool
+
+// slow invocations only.
+var slow time.Duration
+
 // example output.
 const example = `
     Print logs for all functions
@@ -39,7 +62,22 @@ const example = `
     $ apex logs auth*
 
     Print logs for functions with a specified start time, e.g. 5 minutes
-    $ apex logs foo bar --since 5m`
+    $ apex logs foo bar --since 5m
+
+    Print logs between absolute times
+    $ apex logs api --start "2024-03-01 10:00" --end "2024-03-01 11:00"
+
+    Print errors only
+    $ apex logs api --level error
+
+    Print JSON logs with a field value
+    $ apex logs api --where user_id=42 --where req.method=POST
+
+    Follow invocations grouped by request
+    $ apex logs -f --requests
+
+    Print failed invocations, or lasting 3 seconds or more
+    $ apex logs api --requests --failed --slow 3s`
 
 // Command config.
 var Command = &cobra.Command{
@@ -57,6 +95,13 @@ func init() {
 	f.DurationVarP(&duration, "since", "s", 5*time.Minute, "Start time of the search")
 	f.StringVarP(&filter, "filter", "F", "", "Filter logs with pattern")
 	f.BoolVarP(&follow, "follow", "f", false, "Follow tails logs for updates")
+	f.StringVar(&startTime, "start", "", "Start time of the search, overriding --since")
+	f.StringVar(&endTime, "end", "", "End time of the search")
+	f.StringVar(&level, "level", "", "Minimum level of logs, such as info or error")
+	f.StringSliceVarP(&where, "where", "w", nil, "Filter JSON logs by field with name=value")
+	f.BoolVar(&requests, "requests", false, "Group logs by invocation")
+	f.BoolVar(&failed, "failed", false, "Output failed invocations only, with --requests")
+	f.DurationVar(&slow, "slow", 0, "Output invocations lasting at least the duration only, with --requests")
 }
 
 // Run command.
@@ -65,25 +110,382 @@ func run(c *cobra.Command, args []string) error {
 		return err
 	}
 
+	start, end, err := timeRange(duration, startTime, endTime)
+	if err != nil {
+		return err
+	}
+
+	if follow && !end.IsZero() {
+		return errors.New("--follow cannot be used with --end")
+	}
+
 	config := logs.Config{
 		Service:       cloudwatchlogs.New(root.Session),
-		StartTime:     time.Now().Add(-duration).UTC(),
+		StartTime:     start,
+		EndTime:       end,
 		PollInterval:  5 * time.Second,
 		Follow:        follow,
 		FilterPattern: filter,
 	}
 
+	var f logs.Filter
+
+	if level != "" {
+		l, err := logs.ParseLevel(level)
+		if err != nil {
+			return err
+		}
+
+		f.Level = l
+	}
+
+	if len(where) > 0 {
+		f.Where = make(map[string]string)
+	}
+
+	for _, s := range where {
+		parts := strings.SplitN(s, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid --where %q, must be name=value", s)
+		}
+
+		f.Where[parts[0]] = parts[1]
+	}
+
+	if !requests && (failed || slow > 0) {
+		return errors.New("--failed and --slow require --requests")
+	}
+
+	if requests && filter != "" {
+		return errors.New("--filter cannot be used with --requests, use --level or --where")
+	}
+
+	if !requests {
+		config.Filter = f
+	}
+
 	l := &logs.Logs{
 		Config: config,
+		Groups: groups(),
 	}
 
-	for _, fn := range root.Project.Functions {
-		l.GroupNames = append(l.GroupNames, fn.GroupName())
+	if requests {
+		r := &logs.Requests{
+			Failed: failed,
+			Slow:   slow,
+			Filter: f,
+		}
+
+		return outputRequests(l, r)
 	}
 
+	return outputEvents(l)
+}
+
+// outputEvents outputs each event.
+func outputEvents(l *logs.Logs) error {
 	for event := range l.Start() {
-		fmt.Printf("\033[34m%s\033[0m %s", event.GroupName, event.Message)
+		if !root.JSON() {
+			fmt.Println(render(event))
+			continue
+		}
+
+		if err := root.Output(newRecord(event)); err != nil {
+			return err
+		}
 	}
 
 	return l.Err()
 }
+
+// outputRequests outputs each invocation with its events.
+func outputRequests(l *logs.Logs, r *logs.Requests) error {
+	for inv := range r.Start(l.Start()) {
+		if !root.JSON() {
+			fmt.Println(renderInvocation(inv))
+			for _, e := range inv.Events {
+				fmt.Printf("    \033[%dm%s\033[0m %s\n", colors.Gray, e.Timestamp.Local().Format("15:04:05.000"), body(e))
+			}
+			fmt.Println()
+			continue
+		}
+
+		rec := invocationRecord{
+			Group:          inv.GroupName,
+			Function:       inv.Function,
+			Region:         inv.Region,
+			RequestID:      inv.RequestID,
+			Timestamp:      inv.Timestamp,
+			Status:         inv.Status,
+			Duration:       millis(inv.Duration),
+			BilledDuration: millis(inv.BilledDuration),
+			InitDuration:   millis(inv.InitDuration),
+			MemorySize:     inv.MemorySize,
+			MaxMemoryUsed:  inv.MaxMemoryUsed,
+			ColdStart:      inv.ColdStart(),
+			Events:         []record{},
+		}
+
+		for _, e := range inv.Events {
+			rec.Events = append(rec.Events, newRecord(e))
+		}
+
+		if err := root.Output(rec); err != nil {
+			return err
+		}
+	}
+
+	return l.Err()
+}
+
+// newRecord returns the record of event `e`.
+func newRecord(e *logs.Event) record {
+	return record{
+		ID:        e.ID,
+		Group:     e.GroupName,
+		Function:  e.Function,
+		Region:    e.Region,
+		Stream:    e.StreamName,
+		Timestamp: e.Timestamp,
+		RequestID: e.RequestID,
+		Level:     e.Level,
+		Message:   strings.TrimRight(e.Message, "\n"),
+		Fields:    e.Fields,
+	}
+}
+
+// invocationRecord of an invocation, durations in milliseconds.
+type invocationRecord struct {
+	Group          string    `json:"group"`
+	Function       string    `json:"function"`
+	Region         string    `json:"region,omitempty"`
+	RequestID      string    `json:"request_id"`
+	Timestamp      time.Time `json:"timestamp"`
+	Status         string    `json:"status"`
+	Duration       float64   `json:"duration"`
+	BilledDuration float64   `json:"billed_duration"`
+	InitDuration   float64   `json:"init_duration,omitempty"`
+	MemorySize     int       `json:"memory_size"`
+	MaxMemoryUsed  int       `json:"max_memory_used"`
+	ColdStart      bool      `json:"cold_start"`
+	Events         []record  `json:"events"`
+}
+
+// millis returns `d` in milliseconds.
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// statusColors by invocation status.
+var statusColors = map[string]int{
+	logs.StatusSuccess:    colors.Green,
+	logs.StatusError:      colors.Red,
+	logs.StatusTimeout:    colors.Red,
+	logs.StatusIncomplete: colors.Yellow,
+}
+
+// renderInvocation returns the summary line of the invocation `inv`.
+func renderInvocation(inv *logs.Invocation) string {
+	e := &logs.Event{Function: inv.Function, GroupName: inv.GroupName, Region: inv.Region}
+
+	line := fmt.Sprintf("\033[%dm%s\033[0m \033[%dm%s\033[0m %s \033[%dm%s\033[0m",
+		colors.Blue, name(e),
+		colors.Gray, inv.RequestID,
+		inv.Timestamp.Local().Format("15:04:05.000"),
+		statusColors[inv.Status], inv.Status)
+
+	if inv.Status == logs.StatusIncomplete {
+		return line
+	}
+
+	line += fmt.Sprintf(" %s (billed %s) %d/%d MB", inv.Duration, inv.BilledDuration, inv.MaxMemoryUsed, inv.MemorySize)
+
+	if inv.ColdStart() {
+		line += fmt.Sprintf(" \033[%dmcold start %s\033[0m", colors.Yellow, inv.InitDuration)
+	}
+
+	return line
+}
+
+// groups returns the log groups of the loaded functions.
+func groups() []logs.Group {
+	var list []logs.Group
+	services := make(map[string]cloudwatchlogsiface.CloudWatchLogsAPI)
+
+	for _, fn := range root.Project.Functions {
+		group := logs.Group{
+			Name:     fn.GroupName(),
+			Function: fn.Name,
+		}
+
+		if config := fn.AWSConfig(); config != nil {
+			region := *config.Region
+			if _, ok := services[region]; !ok {
+				services[region] = cloudwatchlogs.New(root.Session, config)
+			}
+
+			group.Service = services[region]
+
+			if len(fn.Regions) > 0 {
+				group.Region = region
+			}
+		}
+
+		list = append(list, group)
+	}
+
+	return list
+}
+
+// timeLayouts supported by --start and --end, in the local time zone unless specified.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// parseTime parses the absolute time `s`.
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q, must be formatted as 2006-01-02, 2006-01-02 15:04 or RFC3339", s)
+}
+
+// timeRange returns the start time, either `start` or `since` ago,
+// and the end time when `end` is specified.
+func timeRange(since time.Duration, start, end string) (s time.Time, e time.Time, err error) {
+	s = time.Now().Add(-since).UTC()
+
+	if start != "" {
+		if s, err = parseTime(start); err != nil {
+			return
+		}
+	}
+
+	if end != "" {
+		if e, err = parseTime(end); err != nil {
+			return
+		}
+
+		if !e.After(s) {
+			err = errors.New("end time must be after the start time")
+		}
+	}
+
+	return
+}
+
+// record of a log event.
+type record struct {
+	ID        string                 `json:"id,omitempty"`
+	Group     string                 `json:"group"`
+	Function  string                 `json:"function"`
+	Region    string                 `json:"region,omitempty"`
+	Stream    string                 `json:"stream"`
+	Timestamp time.Time              `json:"timestamp"`
+	RequestID string                 `json:"request_id,omitempty"`
+	Level     string                 `json:"level,omitempty"`
+	Message   string                 `json:"message"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+// name returns the function name of `e`, with the region when specified.
+func name(e *logs.Event) string {
+	name := e.Function
+	if name == "" {
+		name = e.GroupName
+	}
+
+	if e.Region == "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s (%s)", name, e.Region)
+}
+
+// levelColors by level.
+var levelColors = map[string]int{
+	"trace": colors.Gray,
+	"debug": colors.Gray,
+	"info":  colors.Blue,
+	"warn":  colors.Yellow,
+	"error": colors.Red,
+	"fatal": colors.Red,
+}
+
+// messageFields are the JSON fields shown as the message, in order of precedence.
+var messageFields = []string{"message", "msg"}
+
+// hiddenFields are the JSON fields not shown, as they are shown otherwise.
+var hiddenFields = []string{"level", "severity", "lvl", "time", "timestamp", "requestId", "request_id", "AWSRequestId"}
+
+// render returns the event `e` for the terminal, with its time and function.
+func render(e *logs.Event) string {
+	return fmt.Sprintf("\033[%dm%s\033[0m \033[%dm%s\033[0m %s", colors.Gray, e.Timestamp.Local().Format("15:04:05.000"), colors.Blue, name(e), body(e))
+}
+
+// body returns the message of event `e` for the terminal, with its level
+// when known, and the fields of JSON messages as name=value pairs.
+func body(e *logs.Event) string {
+	var line string
+
+	if e.Level != "" {
+		line += fmt.Sprintf("\033[%dm%-5s\033[0m ", levelColors[e.Level], strings.ToUpper(e.Level))
+	}
+
+	if e.Fields == nil {
+		if e.Level == "" && e.RequestID != "" {
+			return line + fmt.Sprintf("\033[%dm%s\033[0m", colors.Gray, e.Text)
+		}
+
+		return line + e.Text
+	}
+
+	var parts []string
+	hidden := append([]string{}, hiddenFields...)
+
+	for _, field := range messageFields {
+		if s, ok := e.Fields[field].(string); ok {
+			parts = append(parts, s)
+			hidden = append(hidden, field)
+			break
+		}
+	}
+
+	var names []string
+	for name := range e.Fields {
+		if !contains(hidden, name) {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := logs.FormatValue(e.Fields[name])
+		if strings.ContainsAny(value, " \t\n\"") {
+			value = fmt.Sprintf("%q", value)
+		}
+
+		parts = append(parts, fmt.Sprintf("\033[%dm%s=\033[0m%s", colors.Gray, name, value))
+	}
+
+	return line + strings.Join(parts, " ")
+}
+
+// contains returns true if `s` is in `list`.
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,219 @@
+package logs
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/tj/cobra"
+
+	"github.com/apex/apex/cmd/apex/root"
+	"github.com/apex/apex/colors"
+	"github.com/apex/apex/logs"
+)
+
+// query flags.
+var (
+	querySince time.Duration
+	queryUntil time.Duration
+	queryLimit int64
+)
+
+// queryExample output.
+const queryExample = `
+    Count errors by message over the last hour for all functions
+    $ apex logs query 'filter @message like /ERROR/ | stats count(*) by @message'
+
+    Run a built-in query for a function over the last day
+    $ apex logs query slowest api --since 24h
+
+    Output cold starts per hour as JSON
+    $ apex logs query cold-starts --output json`
+
+// queryCommand config.
+var queryCommand = &cobra.Command{
+	Use:     "query <query> [<name>...]",
+	Short:   "Run a Logs Insights query over function logs",
+	Long:    "Run a CloudWatch Logs Insights query over the log groups of functions, or one of the built-in queries: " + strings.Join(logs.QueryNames(), ", ") + ".",
+	Example: queryExample,
+	RunE:    runQuery,
+}
+
+// Initialize.
+func init() {
+	Command.AddCommand(queryCommand)
+
+	f := queryCommand.Flags()
+	f.DurationVarP(&querySince, "since", "s", time.Hour, "Start time of the query")
+	f.DurationVar(&queryUntil, "until", 0, "End time of the query, relative to now")
+	f.Int64Var(&queryLimit, "limit", 0, "Maximum number of results, unless limited by the query")
+}
+
+// queryRecord of a result.
+type queryRecord struct {
+	Region string            `json:"region,omitempty"`
+	Fields map[string]string `json:"fields"`
+}
+
+// Run query command.
+func runQuery(c *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return errors.New("query required")
+	}
+
+	q := args[0]
+	if named, ok := logs.Queries[q]; ok {
+		q = named
+	}
+
+	if err := root.Project.LoadFunctions(args[1:]...); err != nil {
+		return err
+	}
+
+	end := time.Now().Add(-queryUntil)
+	start := end.Add(-querySince)
+
+	// log groups by region, queries are regional
+	groups := make(map[string][]string)
+	configs := make(map[string]*aws.Config)
+
+	for _, fn := range root.Project.Functions {
+		var region string
+		if config := fn.AWSConfig(); config != nil {
+			region = *config.Region
+			configs[region] = config
+		}
+
+		if !contains(groups[region], fn.GroupName()) {
+			groups[region] = append(groups[region], fn.GroupName())
+		}
+	}
+
+	var regions []string
+	for region := range groups {
+		regions = append(regions, region)
+	}
+
+	sort.Strings(regions)
+
+	var rows []logs.Row
+	var rowRegions []string
+
+	for _, region := range regions {
+		service := cloudwatchlogs.New(root.Session)
+		if config := configs[region]; config != nil {
+			service = cloudwatchlogs.New(root.Session, config)
+		}
+
+		query := logs.Query{
+			Service:   service,
+			Groups:    groups[region],
+			Query:     q,
+			StartTime: start,
+			EndTime:   end,
+			Limit:     queryLimit,
+		}
+
+		res, err := query.Run()
+		if err != nil {
+			if region != "" {
+				return fmt.Errorf("region %s: %s", region, err)
+			}
+			return err
+		}
+
+		for _, row := range res {
+			rows = append(rows, row)
+			rowRegions = append(rowRegions, region)
+		}
+	}
+
+	// the region is only relevant with several regions
+	if len(regions) < 2 {
+		rowRegions = make([]string, len(rows))
+	}
+
+	if root.JSON() {
+		for i, row := range rows {
+			fields := make(map[string]string)
+			for _, f := range row {
+				fields[f.Name] = f.Value
+			}
+
+			if err := root.Output(queryRecord{Region: rowRegions[i], Fields: fields}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	outputTable(rows, rowRegions)
+	return nil
+}
+
+// outputTable outputs `rows` as a table with a column per field.
+func outputTable(rows []logs.Row, regions []string) {
+	if len(rows) == 0 {
+		fmt.Printf("\n  no results\n\n")
+		return
+	}
+
+	columns := logs.Columns(rows)
+
+	header := append([]string{}, columns...)
+	if regions[0] != "" {
+		header = append([]string{"region"}, header...)
+	}
+
+	cells := [][]string{header}
+
+	for i, row := range rows {
+		var line []string
+		if regions[i] != "" {
+			line = append(line, regions[i])
+		}
+
+		for _, name := range columns {
+			line = append(line, strings.Replace(strings.TrimRight(row.Get(name), "\n"), "\n", " ", -1))
+		}
+
+		cells = append(cells, line)
+	}
+
+	widths := make([]int, len(header))
+	for _, line := range cells {
+		for i, cell := range line {
+			if len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+
+	fmt.Println()
+
+	for i, line := range cells {
+		var b strings.Builder
+		b.WriteString("  ")
+
+		for j, cell := range line {
+			if j == len(line)-1 {
+				b.WriteString(cell)
+			} else {
+				fmt.Fprintf(&b, "%-*s  ", widths[j], cell)
+			}
+		}
+
+		if i == 0 {
+			fmt.Printf("\033[%dm%s\033[0m\n", colors.Blue, b.String())
+		} else {
+			fmt.Println(b.String())
+		}
+	}
+
+	fmt.Println()
+}
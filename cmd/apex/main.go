@@ -12,10 +12,14 @@ import (
 	_ "github.com/apex/apex/cmd/apex/alias"
 	_ "github.com/apex/apex/cmd/apex/autocomplete"
 	_ "github.com/apex/apex/cmd/apex/build"
+	_ "github.com/apex/apex/cmd/apex/config"
+	_ "github.com/apex/apex/cmd/apex/cost"
 	_ "github.com/apex/apex/cmd/apex/delete"
 	_ "github.com/apex/apex/cmd/apex/deploy"
+	_ "github.com/apex/apex/cmd/apex/diff"
 	_ "github.com/apex/apex/cmd/apex/docs"
 	_ "github.com/apex/apex/cmd/apex/exec"
+	_ "github.com/apex/apex/cmd/apex/history"
 	_ "github.com/apex/apex/cmd/apex/infra"
 	_ "github.com/apex/apex/cmd/apex/init"
 	_ "github.com/apex/apex/cmd/apex/invoke"
@@ -24,6 +28,7 @@ import (
 	_ "github.com/apex/apex/cmd/apex/metrics"
 	_ "github.com/apex/apex/cmd/apex/rollback"
 	_ "github.com/apex/apex/cmd/apex/upgrade"
+	_ "github.com/apex/apex/cmd/apex/validate"
 	_ "github.com/apex/apex/cmd/apex/version"
 
 	// plugins
@@ -3,22 +3,38 @@ package metrics
 
 import (
 	"fmt"
+	"math"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/dustin/go-humanize"
 	"github.com/tj/cobra"
 
 	"github.com/apex/apex/cmd/apex/root"
 	"github.com/apex/apex/colors"
 	"github.com/apex/apex/cost"
+	"github.com/apex/apex/function"
 	"github.com/apex/apex/metrics"
 )
 
 // duration of results.
 var duration time.Duration
 
+// period of time series buckets.
+var period time.Duration
+
+// table output of time series.
+var table bool
+
+// alias and version scoping metrics.
+var alias, version string
+
 // example output.
 const example = `
     Print the last 24 hours of metrics for all functions
@@ -28,7 +44,16 @@ const example = `
     $ apex metrics foo
 
     Print metrics for a function with a specified start time, e.g. the last 3 days
-    $ apex metrics foo --since 72h`
+    $ apex metrics foo --since 72h
+
+    Print hourly sparklines of the last day
+    $ apex metrics foo --period 1h
+
+    Print a table of 5 minute buckets of the last hour
+    $ apex metrics foo --since 1h --period 5m --table
+
+    Print metrics of the version executed through the "current" alias
+    $ apex metrics foo --alias current --version 12`
 
 // Command config.
 var Command = &cobra.Command{
@@ -44,6 +69,24 @@ func init() {
 
 	f := Command.Flags()
 	f.DurationVarP(&duration, "since", "s", 24*time.Hour, "Start time of the results")
+	f.DurationVar(&period, "period", 0, "Period of time series buckets, rounded up to a minute")
+	f.BoolVar(&table, "table", false, "Output time series as a table instead of sparklines, with --period")
+	f.StringVar(&alias, "alias", "", "Scope metrics to an alias")
+	f.StringVar(&version, "version", "", "Scope metrics to a version, executed through --alias when specified")
+}
+
+// sparkStats are the statistics shown as sparklines and table columns.
+var sparkStats = []struct {
+	metrics.Stat
+	Label string
+}{
+	{metrics.Stat{Metric: "Invocations", Stat: "Sum"}, "invocations"},
+	{metrics.Stat{Metric: "Errors", Stat: "Sum"}, "errors"},
+	{metrics.Stat{Metric: "Throttles", Stat: "Sum"}, "throttles"},
+	{metrics.Stat{Metric: "Duration", Stat: "Average"}, "avg"},
+	{metrics.Stat{Metric: "Duration", Stat: "p99"}, "p99"},
+	{metrics.Stat{Metric: "Duration", Stat: "Maximum"}, "max"},
+	{metrics.Stat{Metric: "ConcurrentExecutions", Stat: "Maximum"}, "concurrency"},
 }
 
 // Run command.
@@ -52,47 +95,379 @@ func run(c *cobra.Command, args []string) error {
 		return err
 	}
 
-	service := lambda.New(root.Session)
+	if table && period == 0 {
+		return fmt.Errorf("--table requires --period")
+	}
 
 	config := metrics.Config{
-		Service:   cloudwatch.New(root.Session),
 		StartDate: time.Now().UTC().Add(-duration),
 		EndDate:   time.Now().UTC(),
 	}
 
-	m := metrics.Metrics{
-		Config: config,
-	}
+	// metrics are collected in the region of each function
+	var results []result
+	regions := make(map[string]*clients)
 
 	for _, fn := range root.Project.Functions {
-		m.FunctionNames = append(m.FunctionNames, fn.FunctionName)
-	}
+		region, c := regional(regions, fn)
 
-	aggregated := m.Collect()
+		m := metrics.Metrics{
+			Config:        config,
+			FunctionNames: []string{fn.FunctionName},
+			Alias:         alias,
+			Version:       version,
+		}
 
-	fmt.Println()
-	for _, fn := range root.Project.Functions {
-		m := aggregated[fn.FunctionName]
+		m.Service = c.CloudWatch
 
-		conf, err := service.GetFunctionConfiguration(&lambda.GetFunctionConfigurationInput{FunctionName: &fn.FunctionName})
+		aggregated, err := m.Collect()
 		if err != nil {
 			return err
 		}
 
-		memory := int(*conf.MemorySize)
-		costTotal := humanize.FormatFloat("", cost.Cost(m.Invocations, m.Duration, memory))
-		costDuration := humanize.FormatFloat("", cost.DurationCost(m.Duration, memory))
-		costInvocations := humanize.FormatFloat("", cost.RequestCost(m.Invocations))
+		r := result{
+			Function:   fn,
+			Region:     region,
+			Aggregated: aggregated[fn.FunctionName],
+		}
+
+		if period > 0 {
+			series, err := m.Series(period)
+			if err != nil {
+				return err
+			}
+
+			r.Series = series[fn.FunctionName]
+		}
+
+		r.Config, err = c.Lambda.GetFunctionConfiguration(&lambda.GetFunctionConfigurationInput{FunctionName: &fn.FunctionName})
+		if err != nil {
+			return err
+		}
+
+		results = append(results, r)
+	}
+
+	if !root.JSON() {
+		fmt.Println()
+	}
+
+	for _, r := range results {
+		fn := r.Function
+		m := r.Aggregated
+		prices := cost.PricesFor(r.Region)
+		memory := int(*r.Config.MemorySize)
+		arch := function.RemoteArchitecture(r.Config)
+
+		if root.JSON() {
+			err := root.Output(record{
+				Name:                 fn.Name,
+				FunctionName:         fn.FunctionName,
+				Region:               r.Region,
+				Alias:                alias,
+				Version:              version,
+				Start:                config.StartDate,
+				End:                  config.EndDate,
+				Invocations:          m.Invocations,
+				Duration:             m.Duration,
+				DurationAverage:      m.DurationAverage,
+				DurationP50:          m.DurationP50,
+				DurationP90:          m.DurationP90,
+				DurationP99:          m.DurationP99,
+				DurationMax:          m.DurationMax,
+				Throttles:            m.Throttles,
+				Errors:               m.Errors,
+				ConcurrentExecutions: m.ConcurrentExecutions,
+				IteratorAge:          m.IteratorAge,
+				DeadLetterErrors:     m.DeadLetterErrors,
+				Memory:               memory,
+				Architecture:         arch,
+				Cost: costs{
+					Total:       prices.Cost(m.Invocations, m.Duration, memory, arch),
+					Invocations: prices.RequestCost(m.Invocations),
+					Duration:    prices.DurationCost(m.Duration, memory, arch),
+				},
+				Series: seriesRecords(r.Series),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
 
-		fmt.Printf("  \033[%dm%s\033[0m\n", colors.Blue, fn.Name)
+		costTotal := humanize.FormatFloat("", prices.Cost(m.Invocations, m.Duration, memory, arch))
+		costDuration := humanize.FormatFloat("", prices.DurationCost(m.Duration, memory, arch))
+		costInvocations := humanize.FormatFloat("", prices.RequestCost(m.Invocations))
+
+		fmt.Printf("  \033[%dm%s\033[0m \033[%dm%s\033[0m\n", colors.Blue, fn.Name, colors.Gray, r.Region)
 		fmt.Printf("    total cost: $%s\n", costTotal)
 		fmt.Printf("    invocations: %s ($%s)\n", humanize.Comma(int64(m.Invocations)), costInvocations)
 		fmt.Printf("    duration: %s ($%s)\n", time.Millisecond*time.Duration(m.Duration), costDuration)
+		fmt.Printf("    duration avg: %s p50: %s p90: %s p99: %s max: %s\n", ms(m.DurationAverage), ms(m.DurationP50), ms(m.DurationP90), ms(m.DurationP99), ms(m.DurationMax))
 		fmt.Printf("    throttles: %v\n", m.Throttles)
 		fmt.Printf("    errors: %s\n", humanize.Comma(int64(m.Errors)))
+		fmt.Printf("    concurrent executions: %d\n", m.ConcurrentExecutions)
+		fmt.Printf("    iterator age: %s\n", ms(m.IteratorAge))
+		fmt.Printf("    dead letter errors: %s\n", humanize.Comma(int64(m.DeadLetterErrors)))
 		fmt.Printf("    memory: %d\n", memory)
+		fmt.Printf("    architecture: %s\n", arch)
+
+		if period > 0 {
+			fmt.Println()
+			if table {
+				outputTable(r.Series)
+			} else {
+				outputSparklines(r.Series)
+			}
+		}
+
 		fmt.Println()
 	}
 
 	return nil
 }
+
+// result of the metrics of a function in its region.
+type result struct {
+	Function   *function.Function
+	Region     string
+	Config     *lambda.FunctionConfiguration
+	Aggregated metrics.AggregatedMetrics
+	Series     []metrics.Series
+}
+
+// clients of a region.
+type clients struct {
+	Lambda     lambdaiface.LambdaAPI
+	CloudWatch cloudwatchiface.CloudWatchAPI
+}
+
+// regional returns the region of `fn`, defaulting to the region of the
+// session, and its clients, which are shared by functions of the region.
+func regional(regions map[string]*clients, fn *function.Function) (string, *clients) {
+	config := fn.AWSConfig()
+	if config == nil {
+		config = aws.NewConfig()
+	}
+
+	region := aws.StringValue(config.Region)
+	if region == "" {
+		region = aws.StringValue(root.Session.Config.Region)
+	}
+
+	if _, ok := regions[region]; !ok {
+		regions[region] = &clients{
+			Lambda:     lambda.New(root.Session, config),
+			CloudWatch: cloudwatch.New(root.Session, config),
+		}
+	}
+
+	return region, regions[region]
+}
+
+// ms returns the duration of `v` milliseconds rounded for display.
+func ms(v float64) time.Duration {
+	d := time.Duration(v * float64(time.Millisecond))
+
+	if d > time.Second {
+		return d.Round(time.Millisecond)
+	}
+
+	return d.Round(10 * time.Microsecond)
+}
+
+// find returns the series of `stat`.
+func find(list []metrics.Series, stat metrics.Stat) metrics.Series {
+	for _, s := range list {
+		if s.Stat == stat {
+			return s
+		}
+	}
+
+	return metrics.Series{Stat: stat}
+}
+
+// timeline returns the times of the buckets of `list`, as empty buckets are omitted by CloudWatch.
+func timeline(list []metrics.Series) []time.Time {
+	seen := make(map[time.Time]bool)
+	var times []time.Time
+
+	for _, s := range list {
+		for _, p := range s.Points {
+			if !seen[p.Time] {
+				seen[p.Time] = true
+				times = append(times, p.Time)
+			}
+		}
+	}
+
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+
+	return times
+}
+
+// values returns the values of `s` at `times`, zero for missing buckets.
+func values(s metrics.Series, times []time.Time) []float64 {
+	byTime := make(map[time.Time]float64)
+	for _, p := range s.Points {
+		byTime[p.Time] = p.Value
+	}
+
+	v := make([]float64, len(times))
+	for i, t := range times {
+		v[i] = byTime[t]
+	}
+
+	return v
+}
+
+// ticks of sparklines.
+var ticks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline returns the sparkline of `values`.
+func sparkline(values []float64) string {
+	var max float64
+	for _, v := range values {
+		max = math.Max(max, v)
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		i := 0
+		if max > 0 {
+			i = int(math.Round(v / max * float64(len(ticks)-1)))
+		}
+		b.WriteRune(ticks[i])
+	}
+
+	return b.String()
+}
+
+// format returns the value `v` of `stat` for display.
+func format(stat metrics.Stat, v float64) string {
+	if stat.Metric == "Duration" {
+		return ms(v).String()
+	}
+
+	return humanize.Comma(int64(v))
+}
+
+// outputSparklines outputs a sparkline per statistic, with its maximum.
+func outputSparklines(list []metrics.Series) {
+	times := timeline(list)
+
+	if len(times) == 0 {
+		fmt.Printf("    no datapoints\n")
+		return
+	}
+
+	fmt.Printf("    \033[%dm%s - %s\033[0m\n", colors.Gray, times[0].Local().Format("Jan 2 15:04"), times[len(times)-1].Local().Format("Jan 2 15:04"))
+
+	for _, s := range sparkStats {
+		v := values(find(list, s.Stat), times)
+
+		var max float64
+		for _, n := range v {
+			max = math.Max(max, n)
+		}
+
+		fmt.Printf("    %-12s %s \033[%dmmax %s\033[0m\n", s.Label, sparkline(v), colors.Gray, format(s.Stat, max))
+	}
+}
+
+// outputTable outputs a row per bucket with a column per statistic.
+func outputTable(list []metrics.Series) {
+	times := timeline(list)
+
+	if len(times) == 0 {
+		fmt.Printf("    no datapoints\n")
+		return
+	}
+
+	cols := make([][]float64, len(sparkStats))
+	for i, s := range sparkStats {
+		cols[i] = values(find(list, s.Stat), times)
+	}
+
+	var header strings.Builder
+	fmt.Fprintf(&header, "%-12s", "time")
+	for _, s := range sparkStats {
+		fmt.Fprintf(&header, "  %12s", s.Label)
+	}
+
+	fmt.Printf("    \033[%dm%s\033[0m\n", colors.Gray, header.String())
+
+	for i, t := range times {
+		var row strings.Builder
+		fmt.Fprintf(&row, "%-12s", t.Local().Format("Jan 2 15:04"))
+		for j, s := range sparkStats {
+			fmt.Fprintf(&row, "  %12s", format(s.Stat, cols[j][i]))
+		}
+
+		fmt.Printf("    %s\n", row.String())
+	}
+}
+
+// record of function metrics, durations in milliseconds.
+type record struct {
+	Name                 string                   `json:"name"`
+	FunctionName         string                   `json:"function_name"`
+	Region               string                   `json:"region"`
+	Alias                string                   `json:"alias,omitempty"`
+	Version              string                   `json:"version,omitempty"`
+	Start                time.Time                `json:"start"`
+	End                  time.Time                `json:"end"`
+	Invocations          int                      `json:"invocations"`
+	Duration             int                      `json:"duration"`
+	DurationAverage      float64                  `json:"duration_avg"`
+	DurationP50          float64                  `json:"duration_p50"`
+	DurationP90          float64                  `json:"duration_p90"`
+	DurationP99          float64                  `json:"duration_p99"`
+	DurationMax          float64                  `json:"duration_max"`
+	Throttles            int                      `json:"throttles"`
+	Errors               int                      `json:"errors"`
+	ConcurrentExecutions int                      `json:"concurrent_executions"`
+	IteratorAge          float64                  `json:"iterator_age"`
+	DeadLetterErrors     int                      `json:"dead_letter_errors"`
+	Memory               int                      `json:"memory"`
+	Architecture         string                   `json:"architecture"`
+	Cost                 costs                    `json:"cost"`
+	Series               map[string][]pointRecord `json:"series,omitempty"`
+}
+
+// costs in USD.
+type costs struct {
+	Total       float64 `json:"total"`
+	Invocations float64 `json:"invocations"`
+	Duration    float64 `json:"duration"`
+}
+
+// pointRecord of a time series.
+type pointRecord struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// seriesRecords returns the records of `list` by statistic, such as "Duration p99".
+func seriesRecords(list []metrics.Series) map[string][]pointRecord {
+	if len(list) == 0 {
+		return nil
+	}
+
+	m := make(map[string][]pointRecord)
+
+	for _, s := range list {
+		points := []pointRecord{}
+		for _, p := range s.Points {
+			points = append(points, pointRecord{Time: p.Time, Value: p.Value})
+		}
+
+		m[s.Stat.String()] = points
+	}
+
+	return m
+}
@@ -2,6 +2,8 @@
 package rollback
 
 import (
+	"errors"
+
 	"github.com/tj/cobra"
 
 	"github.com/apex/apex/cmd/apex/root"
@@ -13,6 +15,9 @@ var alias string
 // version target.
 var version string
 
+// commit target.
+var commit string
+
 // example output.
 const example = `
     Rollback all functions to the previous version
@@ -25,7 +30,10 @@ const example = `
     $ apex rollback auth*
 
     Rollback a function to the specified version
-    $ apex rollback bar -v 3`
+    $ apex rollback bar -v 3
+
+    Rollback a function to the version deployed from a git commit
+    $ apex rollback bar --to 3f2c1a9`
 
 // Command config.
 var Command = &cobra.Command{
@@ -42,6 +50,7 @@ func init() {
 	f := Command.Flags()
 	f.StringVarP(&alias, "alias", "a", "current", "Function alias")
 	f.StringVarP(&version, "version", "v", "", "version to which rollback is done")
+	f.StringVar(&commit, "to", "", "git commit to which rollback is done")
 }
 
 // Run command.
@@ -52,9 +61,22 @@ func run(c *cobra.Command, args []string) error {
 		return err
 	}
 
-	if version == "" {
-		return root.Project.Rollback()
+	var err error
+
+	switch {
+	case version != "" && commit != "":
+		return errors.New("--version and --to are mutually exclusive")
+	case commit != "":
+		err = root.Project.RollbackCommit(commit)
+	case version != "":
+		err = root.Project.RollbackVersion(version)
+	default:
+		err = root.Project.Rollback()
+	}
+
+	if err == nil && root.JSON() {
+		err = root.OutputReleases(alias)
 	}
 
-	return root.Project.RollbackVersion(version)
+	return err
 }
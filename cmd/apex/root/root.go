@@ -1,6 +1,8 @@
 package root
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 
 	"github.com/apex/log"
@@ -9,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tj/cobra"
 
+	"github.com/apex/apex/dryrun"
 	"github.com/apex/apex/project"
 	"github.com/apex/apex/service"
 	"github.com/apex/apex/utils"
@@ -38,6 +41,9 @@ var region string
 // endpoint for AWS.
 var endpoint string
 
+// output format.
+var output string
+
 // Session instance.
 var Session *session.Session
 
@@ -72,6 +78,7 @@ func init() {
 	f.StringVarP(&iamrole, "iamrole", "i", "", "AWS iamrole")
 	f.StringVarP(&region, "region", "r", "", "AWS region")
 	f.StringVar(&endpoint, "endpoint", "", "AWS endpoint")
+	f.StringVarP(&output, "output", "o", "text", "Output format (text or json)")
 }
 
 // PreRunNoop noop for other commands.
@@ -104,6 +111,10 @@ func Prepare(c *cobra.Command, args []string) error {
 		log.SetLevel(l)
 	}
 
+	if output != "text" && output != "json" {
+		return fmt.Errorf("invalid output format %q, must be text or json", output)
+	}
+
 	// config defaults
 	Config = aws.NewConfig()
 
@@ -172,6 +183,7 @@ func Prepare(c *cobra.Command, args []string) error {
 	Project = &project.Project{
 		Environment:      environment,
 		InfraEnvironment: environment,
+		DryRun:           dryRun,
 		Log:              log.Log,
 		Path:             ".",
 	}
@@ -180,7 +192,60 @@ func Prepare(c *cobra.Command, args []string) error {
 		log.SetLevel(log.WarnLevel)
 		Project.Concurrency = 1
 	}
+
+	// stdout is reserved for JSON records
+	if dryRun && JSON() {
+		dryrun.Writer = os.Stderr
+	}
 	Project.ServiceProvider = service.NewProvider(Session, dryRun)
 
 	return nil
 }
+
+// JSON returns true when records should be output as JSON.
+func JSON() bool {
+	return output == "json"
+}
+
+// SetJSON enables JSON output, for commands with a flag of their own for it.
+func SetJSON() {
+	output = "json"
+}
+
+// Output writes `v` to stdout as a single line of JSON.
+func Output(v interface{}) error {
+	return json.NewEncoder(os.Stdout).Encode(v)
+}
+
+// release of a function alias.
+type release struct {
+	Name         string `json:"name"`
+	FunctionName string `json:"function_name"`
+	Region       string `json:"region,omitempty"`
+	Alias        string `json:"alias"`
+	Version      string `json:"version"`
+}
+
+// OutputReleases writes the version `alias` points to for each function as JSON.
+func OutputReleases(alias string) error {
+	for _, fn := range Project.Functions {
+		version, err := fn.GetVersionFromAlias(alias)
+		if err != nil {
+			return fmt.Errorf("function %s: %s", fn.Name, err)
+		}
+
+		err = Output(release{
+			Name:         fn.Name,
+			FunctionName: fn.FunctionName,
+			Region:       fn.Region,
+			Alias:        alias,
+			Version:      version,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// Package validate checks function configurations against Lambda limits.
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/tj/cobra"
+
+	"github.com/apex/apex/cmd/apex/root"
+	"github.com/apex/apex/colors"
+	"github.com/apex/apex/function"
+)
+
+// example output.
+const example = `
+    Validate all functions
+    $ apex validate
+
+    Validate specific functions
+    $ apex validate foo bar
+
+    Validate the production environment's configuration
+    $ apex validate --env prod`
+
+// Command config.
+var Command = &cobra.Command{
+	Use:     "validate [<name>...]",
+	Short:   "Validate functions against Lambda limits without calling AWS",
+	Example: example,
+	RunE:    run,
+}
+
+// Initialize.
+func init() {
+	root.Register(Command)
+}
+
+// Run command.
+func run(c *cobra.Command, args []string) error {
+	if err := root.Project.LoadFunctions(args...); err != nil {
+		return err
+	}
+
+	err := root.Project.Validate(true)
+
+	problems, ok := err.(function.Problems)
+	if !ok {
+		return err
+	}
+
+	if root.JSON() {
+		for _, p := range problems {
+			if err := root.Output(p); err != nil {
+				return err
+			}
+		}
+	} else {
+		outputProblems(problems)
+	}
+
+	if len(problems) > 0 {
+		return errors.New("validation failed")
+	}
+
+	return nil
+}
+
+// outputProblems grouped by function.
+func outputProblems(problems function.Problems) {
+	fmt.Fprintln(os.Stderr)
+
+	var name string
+	for _, p := range problems {
+		if p.Function != name {
+			if name != "" {
+				fmt.Fprintln(os.Stderr)
+			}
+			name = p.Function
+			fmt.Fprintf(os.Stderr, "  \033[%dm%s\033[0m\n", colors.Blue, name)
+		}
+
+		fmt.Fprintf(os.Stderr, "    \033[%dm%s\033[0m %s: %s\n", colors.Gray, p.File, p.Field, p.Message)
+	}
+
+	fmt.Fprintln(os.Stderr)
+}
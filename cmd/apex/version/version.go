@@ -27,5 +27,10 @@ func init() {
 
 // Run command.
 func run(c *cobra.Command, args []string) {
+	if root.JSON() {
+		root.Output(map[string]string{"version": Version})
+		return
+	}
+
 	fmt.Printf("Apex version %s\n", Version)
 }
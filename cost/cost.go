@@ -1,52 +1,233 @@
 // Package cost provides utilities for calculating AWS Lambda pricing.
 package cost
 
-// pricePerRequest is the cost per function invocation.
-var pricePerRequest = 0.0000002
-
-// memoryConfigurations available.
-var memoryConfigurations = map[int]float64{
-	128:  0.000000208,
-	192:  0.000000313,
-	256:  0.000000417,
-	320:  0.000000521,
-	384:  0.000000625,
-	448:  0.000000729,
-	512:  0.000000834,
-	576:  0.000000938,
-	640:  0.000001042,
-	704:  0.000001146,
-	768:  0.00000125,
-	832:  0.000001354,
-	896:  0.000001459,
-	960:  0.000001563,
-	1024: 0.000001667,
-	1088: 0.000001771,
-	1152: 0.000001875,
-	1216: 0.00000198,
-	1280: 0.000002084,
-	1344: 0.000002188,
-	1408: 0.000002292,
-	1472: 0.000002396,
-	1536: 0.000002501,
-}
-
-// Rate returns the cost per 100ms for the given `memory` configuration in megabytes.
-func Rate(memory int) float64 {
-	return memoryConfigurations[memory]
+import (
+	"math"
+	"time"
+)
+
+// Prices of Lambda in a region, in USD.
+type Prices struct {
+	// Request is the price per request.
+	Request float64
+
+	// Duration is the price per GB-second of on-demand invocations by architecture.
+	Duration map[string]float64
+
+	// ProvisionedConcurrency is the price per GB-second of provisioned concurrency by architecture.
+	ProvisionedConcurrency map[string]float64
+
+	// ProvisionedDuration is the price per GB-second of invocations
+	// served by provisioned concurrency by architecture.
+	ProvisionedDuration map[string]float64
+}
+
+// DefaultPrices are the prices of us-east-1 and most other regions.
+var DefaultPrices = Prices{
+	Request: 0.0000002,
+	Duration: map[string]float64{
+		"x86_64": 0.0000166667,
+		"arm64":  0.0000133334,
+	},
+	ProvisionedConcurrency: map[string]float64{
+		"x86_64": 0.0000041667,
+		"arm64":  0.0000033334,
+	},
+	ProvisionedDuration: map[string]float64{
+		"x86_64": 0.0000097222,
+		"arm64":  0.0000077778,
+	},
+}
+
+// RegionPrices are the prices of regions which differ from DefaultPrices.
+// Other regions, including regions more recent than this table, are priced
+// with DefaultPrices, and the China regions billed in CNY are not supported.
+var RegionPrices = map[string]Prices{
+	"af-south-1":   regional(0.00000028, 0.0000221, 0.0000177),
+	"ap-east-1":    regional(0.00000028, 0.00002292, 0.00001833),
+	"eu-south-1":   regional(0.00000023, 0.0000195172, 0.0000156138),
+	"il-central-1": regional(0.00000022, 0.0000183334, 0.0000146667),
+	"me-south-1":   regional(0.00000023, 0.0000206667, 0.0000165334),
+}
+
+// regional returns the prices of a region with the given request and on-demand
+// duration prices, provisioned concurrency prices are estimated in proportion.
+func regional(request, x86, arm float64) Prices {
+	p := Prices{
+		Request:                request,
+		Duration:               map[string]float64{"x86_64": x86, "arm64": arm},
+		ProvisionedConcurrency: make(map[string]float64),
+		ProvisionedDuration:    make(map[string]float64),
+	}
+
+	for arch, price := range p.Duration {
+		ratio := price / DefaultPrices.Duration[arch]
+		p.ProvisionedConcurrency[arch] = DefaultPrices.ProvisionedConcurrency[arch] * ratio
+		p.ProvisionedDuration[arch] = DefaultPrices.ProvisionedDuration[arch] * ratio
+	}
+
+	return p
+}
+
+// PricesFor returns the prices of `region`.
+func PricesFor(region string) Prices {
+	if p, ok := RegionPrices[region]; ok {
+		return p
+	}
+
+	return DefaultPrices
+}
+
+// FreeTier is the monthly usage included in the AWS free tier, across all functions.
+var FreeTier = struct {
+	Requests  float64
+	GBSeconds float64
+}{
+	Requests:  1000000,
+	GBSeconds: 400000,
+}
+
+// GBSeconds returns the GB-seconds of `ms` milliseconds with `memory` megabytes.
+func GBSeconds(ms float64, memory int) float64 {
+	return float64(memory) / 1024 * ms / 1000
+}
+
+// price returns the price of `architecture` in `prices`, defaulting to x86_64.
+func price(prices map[string]float64, architecture string) float64 {
+	if p, ok := prices[architecture]; ok {
+		return p
+	}
+
+	return prices["x86_64"]
+}
+
+// Rate returns the cost per millisecond for the given `memory` configuration in megabytes
+// and `architecture`, which defaults to x86_64 when empty. Any memory size is supported,
+// as Lambda bills per GB-second with 1ms granularity.
+func (p Prices) Rate(memory int, architecture string) float64 {
+	return price(p.Duration, architecture) * GBSeconds(1, memory)
 }
 
 // RequestCost returns the cost of `n` requests.
-func RequestCost(n int) float64 {
-	return pricePerRequest * float64(n)
+func (p Prices) RequestCost(n int) float64 {
+	return p.Request * float64(n)
 }
 
-// DurationCost returns the cost of `ms` for the given `memory` configuration in megabytes.
-func DurationCost(ms, memory int) float64 {
-	return Rate(memory) * (float64(ms) / 100)
+// DurationCost returns the cost of `ms` for the given `memory` configuration in megabytes and `architecture`.
+func (p Prices) DurationCost(ms, memory int, architecture string) float64 {
+	return p.Rate(memory, architecture) * float64(ms)
 }
 
 // Cost returns the total cost.
-func Cost(requests, ms, memory int) float64 {
-	return RequestCost(requests) + DurationCost(ms, memory)
+func (p Prices) Cost(requests, ms, memory int, architecture string) float64 {
+	return p.RequestCost(requests) + p.DurationCost(ms, memory, architecture)
+}
+
+// ProvisionedCost returns the cost of `concurrency` execution environments of `memory`
+// megabytes and `architecture` provisioned for `d`, excluding their invocations.
+func (p Prices) ProvisionedCost(concurrency int, memory int, architecture string, d time.Duration) float64 {
+	return price(p.ProvisionedConcurrency, architecture) * GBSeconds(float64(concurrency)*float64(d/time.Millisecond), memory)
+}
+
+// ProvisionedDurationCost returns the cost of `ms` of invocations served by provisioned
+// concurrency for the given `memory` configuration in megabytes and `architecture`.
+func (p Prices) ProvisionedDurationCost(ms, memory int, architecture string) float64 {
+	return price(p.ProvisionedDuration, architecture) * GBSeconds(float64(ms), memory)
+}
+
+// Usage of a function over a period of time.
+type Usage struct {
+	Requests     int
+	Duration     int // milliseconds
+	Memory       int // megabytes
+	Architecture string
+
+	// ProvisionedConcurrency of the function over the period, when non-zero
+	// the invocations are assumed to be served by it up to its capacity.
+	ProvisionedConcurrency int
+	Period                 time.Duration
+}
+
+// Bill is the cost of a Usage.
+type Bill struct {
+	// Requests cost.
+	Requests float64
+
+	// Duration cost of on-demand invocations.
+	Duration float64
+
+	// Provisioned cost of provisioned concurrency and the invocations it served.
+	Provisioned float64
+
+	// GBSeconds of on-demand invocations.
+	GBSeconds float64
+}
+
+// Total returns the total cost.
+func (b Bill) Total() float64 {
+	return b.Requests + b.Duration + b.Provisioned
+}
+
+// Bill returns the cost of `u`. Invocations served by provisioned concurrency are
+// billed at its duration price, the rest at the on-demand price.
+func (p Prices) Bill(u Usage) Bill {
+	b := Bill{
+		Requests: p.RequestCost(u.Requests),
+	}
+
+	onDemand := u.Duration
+
+	if u.ProvisionedConcurrency > 0 {
+		capacity := int64(u.ProvisionedConcurrency) * int64(u.Period/time.Millisecond)
+		provisioned := int64(u.Duration)
+		if provisioned > capacity {
+			provisioned = capacity
+		}
+
+		onDemand = u.Duration - int(provisioned)
+		b.Provisioned = p.ProvisionedCost(u.ProvisionedConcurrency, u.Memory, u.Architecture, u.Period) +
+			p.ProvisionedDurationCost(int(provisioned), u.Memory, u.Architecture)
+	}
+
+	b.Duration = p.DurationCost(onDemand, u.Memory, u.Architecture)
+	b.GBSeconds = GBSeconds(float64(onDemand), u.Memory)
+	return b
+}
+
+// FreeTierDiscount returns the discount of the monthly free tier on bill `b`, given the total
+// `requests` and on-demand `gbSeconds` of all bills, as the free tier is shared between them in
+// proportion to their usage. Only on-demand invocations are included in the free tier.
+func FreeTierDiscount(b Bill, requests int, gbSeconds float64) float64 {
+	var discount float64
+
+	if requests > 0 {
+		discount += b.Requests * math.Min(1, FreeTier.Requests/float64(requests))
+	}
+
+	if gbSeconds > 0 {
+		discount += b.Duration * math.Min(1, FreeTier.GBSeconds/gbSeconds)
+	}
+
+	return discount
+}
+
+// Rate returns the cost per millisecond in the default region, see Prices.Rate.
+func Rate(memory int, architecture string) float64 {
+	return DefaultPrices.Rate(memory, architecture)
+}
+
+// RequestCost returns the cost of `n` requests in the default region.
+func RequestCost(n int) float64 {
+	return DefaultPrices.RequestCost(n)
+}
+
+// DurationCost returns the cost of `ms` for the given `memory` configuration
+// in megabytes and `architecture` in the default region.
+func DurationCost(ms, memory int, architecture string) float64 {
+	return DefaultPrices.DurationCost(ms, memory, architecture)
+}
+
+// Cost returns the total cost in the default region.
+func Cost(requests, ms, memory int, architecture string) float64 {
+	return DefaultPrices.Cost(requests, ms, memory, architecture)
 }
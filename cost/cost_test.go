@@ -0,0 +1,112 @@
+package cost_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/cost"
+)
+
+func TestRate(t *testing.T) {
+	assert.InDelta(t, 0.0000166667/8000, cost.Rate(128, ""), 1e-15)
+	assert.InDelta(t, 0.0000166667/8000, cost.Rate(128, "x86_64"), 1e-15)
+	assert.InDelta(t, 0.0000166667/1000, cost.Rate(1024, "x86_64"), 1e-15)
+	assert.InDelta(t, 0.0000166667/1000*1769/1024, cost.Rate(1769, "x86_64"), 1e-15)
+	assert.InDelta(t, 0.0000166667/100, cost.Rate(10240, "x86_64"), 1e-15)
+	assert.InDelta(t, 0.0000133334/1000, cost.Rate(1024, "arm64"), 1e-15)
+}
+
+func TestCost(t *testing.T) {
+	assert.InDelta(t, 0.2, cost.RequestCost(1000000), 1e-9)
+	assert.InDelta(t, 16.6667, cost.DurationCost(1000000000, 1024, "x86_64"), 1e-4)
+	assert.InDelta(t, 16.8667, cost.Cost(1000000, 1000000000, 1024, "x86_64"), 1e-4)
+}
+
+func TestPricesFor(t *testing.T) {
+	assert.Equal(t, cost.DefaultPrices.Request, cost.PricesFor("us-west-2").Request)
+
+	p := cost.PricesFor("af-south-1")
+	assert.InDelta(t, 0.00000028, p.Request, 1e-12)
+	assert.InDelta(t, 0.0000221, p.Duration["x86_64"], 1e-12)
+	assert.True(t, p.ProvisionedConcurrency["arm64"] > cost.DefaultPrices.ProvisionedConcurrency["arm64"])
+
+	p = cost.PricesFor("me-south-1")
+	assert.InDelta(t, 0.00000023, p.Request, 1e-12)
+	assert.InDelta(t, 0.0000165334, p.Duration["arm64"], 1e-12)
+}
+
+func TestPrices_Bill(t *testing.T) {
+	t.Run("on-demand", func(t *testing.T) {
+		b := cost.DefaultPrices.Bill(cost.Usage{
+			Requests: 1000000,
+			Duration: 1000000000,
+			Memory:   1024,
+			Period:   30 * 24 * time.Hour,
+		})
+
+		assert.InDelta(t, 0.2, b.Requests, 1e-9)
+		assert.InDelta(t, 16.6667, b.Duration, 1e-4)
+		assert.Equal(t, 0.0, b.Provisioned)
+		assert.Equal(t, 1000000.0, b.GBSeconds)
+		assert.InDelta(t, 16.8667, b.Total(), 1e-4)
+	})
+
+	t.Run("provisioned", func(t *testing.T) {
+		month := 30 * 24 * time.Hour
+		capacity := int(month / time.Millisecond)
+
+		b := cost.DefaultPrices.Bill(cost.Usage{
+			Requests:               1000000,
+			Duration:               capacity + 1000000,
+			Memory:                 1024,
+			Architecture:           "x86_64",
+			ProvisionedConcurrency: 1,
+			Period:                 month,
+		})
+
+		idle := 0.0000041667 * float64(capacity) / 1000
+		served := 0.0000097222 * float64(capacity) / 1000
+
+		assert.InDelta(t, 0.2, b.Requests, 1e-9)
+		assert.InDelta(t, idle+served, b.Provisioned, 1e-6)
+		assert.InDelta(t, 0.0166667, b.Duration, 1e-6)
+		assert.Equal(t, 1000.0, b.GBSeconds)
+	})
+}
+
+func TestFreeTierDiscount(t *testing.T) {
+	t.Run("within", func(t *testing.T) {
+		b := cost.DefaultPrices.Bill(cost.Usage{
+			Requests: 500000,
+			Duration: 100000000,
+			Memory:   1024,
+		})
+
+		assert.InDelta(t, b.Total(), cost.FreeTierDiscount(b, 500000, b.GBSeconds), 1e-9)
+	})
+
+	t.Run("shared", func(t *testing.T) {
+		a := cost.DefaultPrices.Bill(cost.Usage{
+			Requests: 3000000,
+			Duration: 600000000,
+			Memory:   1024,
+		})
+
+		b := cost.DefaultPrices.Bill(cost.Usage{
+			Requests: 1000000,
+			Duration: 200000000,
+			Memory:   1024,
+		})
+
+		requests := 4000000
+		gbSeconds := a.GBSeconds + b.GBSeconds
+
+		da := cost.FreeTierDiscount(a, requests, gbSeconds)
+		db := cost.FreeTierDiscount(b, requests, gbSeconds)
+
+		assert.InDelta(t, 3*db, da, 1e-9)
+		assert.InDelta(t, 0.2+400000*0.0000166667, da+db, 1e-6)
+	})
+}
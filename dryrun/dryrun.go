@@ -3,6 +3,10 @@ package dryrun
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -10,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/dustin/go-humanize"
 
+	"github.com/apex/apex/function"
 	"github.com/apex/apex/utils"
 )
 
@@ -20,6 +25,9 @@ const (
 	blue   = 34
 )
 
+// Writer of the dry-run output.
+var Writer io.Writer = os.Stdout
+
 // Lambda is a partially implemented Lambda API implementation used to perform a dry-run.
 type Lambda struct {
 	*lambda.Lambda
@@ -27,7 +35,7 @@ type Lambda struct {
 
 // New dry-run Lambda service for the given session.
 func New(session *session.Session) *Lambda {
-	fmt.Printf("\n")
+	fmt.Fprintf(Writer, "\n")
 	return &Lambda{
 		Lambda: lambda.New(session),
 	}
@@ -35,12 +43,18 @@ func New(session *session.Session) *Lambda {
 
 // CreateFunction stub.
 func (l *Lambda) CreateFunction(in *lambda.CreateFunctionInput) (*lambda.FunctionConfiguration, error) {
-	l.create("function", *in.FunctionName, map[string]interface{}{
+	m := map[string]interface{}{
 		"runtime": *in.Runtime,
 		"memory":  *in.MemorySize,
 		"timeout": *in.Timeout,
 		"handler": *in.Handler,
-	})
+	}
+
+	if len(in.Architectures) > 0 {
+		m["architecture"] = *in.Architectures[0]
+	}
+
+	l.create("function", *in.FunctionName, m)
 
 	out := &lambda.FunctionConfiguration{
 		Version: aws.String("1"),
@@ -59,15 +73,33 @@ func (l *Lambda) UpdateFunctionCode(in *lambda.UpdateFunctionCodeInput) (*lambda
 		return nil, err
 	}
 
+	if in.S3Key != nil {
+		l.create("function", *in.FunctionName, map[string]interface{}{
+			"code": fmt.Sprintf("s3://%s/%s", *in.S3Bucket, *in.S3Key),
+		})
+
+		return &lambda.FunctionConfiguration{Version: aws.String("$LATEST")}, nil
+	}
+
 	size := uint64(len(in.ZipFile))
 	checksum := utils.Sha256(in.ZipFile)
 	remoteChecksum := *res.Configuration.CodeSha256
 	remoteSize := uint64(*res.Configuration.CodeSize)
 
+	m := make(map[string]interface{})
+
 	if checksum != remoteChecksum {
-		l.create("function", *in.FunctionName, map[string]interface{}{
-			"size": fmt.Sprintf("%s -> %s", humanize.Bytes(remoteSize), humanize.Bytes(size)),
-		})
+		m["size"] = fmt.Sprintf("%s -> %s", humanize.Bytes(remoteSize), humanize.Bytes(size))
+	}
+
+	if len(in.Architectures) > 0 {
+		if a, b := function.RemoteArchitecture(res.Configuration), *in.Architectures[0]; a != b {
+			m["architecture"] = fmt.Sprintf("%s -> %s", a, b)
+		}
+	}
+
+	if len(m) > 0 {
+		l.create("function", *in.FunctionName, m)
 	}
 
 	out := &lambda.FunctionConfiguration{
@@ -77,6 +109,33 @@ func (l *Lambda) UpdateFunctionCode(in *lambda.UpdateFunctionCodeInput) (*lambda
 	return out, nil
 }
 
+// WaitUntilFunctionActive stub.
+func (l *Lambda) WaitUntilFunctionActive(in *lambda.GetFunctionConfigurationInput) error {
+	return nil
+}
+
+// WaitUntilFunctionUpdated stub.
+func (l *Lambda) WaitUntilFunctionUpdated(in *lambda.GetFunctionConfigurationInput) error {
+	return nil
+}
+
+// PublishVersion stub, functions which are not yet deployed publish their first version.
+func (l *Lambda) PublishVersion(in *lambda.PublishVersionInput) (*lambda.FunctionConfiguration, error) {
+	_, err := l.GetFunction(&lambda.GetFunctionInput{
+		FunctionName: in.FunctionName,
+	})
+
+	if e, ok := err.(awserr.Error); ok && e.Code() == "ResourceNotFoundException" {
+		return &lambda.FunctionConfiguration{Version: aws.String("1")}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &lambda.FunctionConfiguration{Version: aws.String("$LATEST")}, nil
+}
+
 // UpdateFunctionConfiguration stub.
 func (l *Lambda) UpdateFunctionConfiguration(in *lambda.UpdateFunctionConfigurationInput) (*lambda.FunctionConfiguration, error) {
 	res, err := l.GetFunctionConfiguration(&lambda.GetFunctionConfigurationInput{
@@ -115,6 +174,19 @@ func (l *Lambda) UpdateFunctionConfiguration(in *lambda.UpdateFunctionConfigurat
 		m["timeout"] = fmt.Sprintf("%v -> %v", *res.Timeout, *in.Timeout)
 	}
 
+	var layers []string
+	for _, l := range res.Layers {
+		layers = append(layers, *l.Arn)
+	}
+
+	if a, b := strings.Join(layers, ", "), strings.Join(aws.StringValueSlice(in.Layers), ", "); a != b {
+		m["layers"] = fmt.Sprintf("%q -> %q", a, b)
+	}
+
+	if c := environmentChanges(res.Environment, in.Environment); c != "" {
+		m["environment"] = c
+	}
+
 	if len(m) > 0 {
 		l.update("config", *in.FunctionName, m)
 	}
@@ -144,19 +216,221 @@ func (l *Lambda) CreateAlias(in *lambda.CreateAliasInput) (*lambda.AliasConfigur
 
 // UpdateAlias stub.
 func (l *Lambda) UpdateAlias(in *lambda.UpdateAliasInput) (*lambda.AliasConfiguration, error) {
-	l.update("alias", *in.FunctionName, map[string]interface{}{
+	m := map[string]interface{}{
 		"alias":   *in.Name,
 		"version": *in.FunctionVersion,
-	})
+	}
+
+	if in.RoutingConfig != nil {
+		for version, weight := range in.RoutingConfig.AdditionalVersionWeights {
+			m["routing"] = fmt.Sprintf("%.0f%% -> %s", *weight*100, version)
+		}
+	}
+
+	l.update("alias", *in.FunctionName, m)
 	return nil, nil
 }
 
+// GetAlias stub, aliases of functions which are not yet deployed are
+// reported with a partial ARN.
+func (l *Lambda) GetAlias(in *lambda.GetAliasInput) (*lambda.AliasConfiguration, error) {
+	out, err := l.Lambda.GetAlias(in)
+
+	if e, ok := err.(awserr.Error); ok && e.Code() == "ResourceNotFoundException" {
+		return &lambda.AliasConfiguration{
+			AliasArn: aws.String(*in.FunctionName + ":" + *in.Name),
+		}, nil
+	}
+
+	return out, err
+}
+
+// CreateEventSourceMapping stub.
+func (l *Lambda) CreateEventSourceMapping(in *lambda.CreateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
+	m := map[string]interface{}{
+		"source":  *in.EventSourceArn,
+		"enabled": *in.Enabled,
+	}
+
+	if in.BatchSize != nil {
+		m["batch size"] = *in.BatchSize
+	}
+
+	if in.StartingPosition != nil {
+		m["starting position"] = *in.StartingPosition
+	}
+
+	l.create("event source", *in.FunctionName, m)
+	return &lambda.EventSourceMappingConfiguration{}, nil
+}
+
+// UpdateEventSourceMapping stub.
+func (l *Lambda) UpdateEventSourceMapping(in *lambda.UpdateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
+	res, err := l.GetEventSourceMapping(&lambda.GetEventSourceMappingInput{
+		UUID: in.UUID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]interface{}{
+		"source":  *res.EventSourceArn,
+		"enabled": *in.Enabled,
+	}
+
+	if in.BatchSize != nil {
+		m["batch size"] = fmt.Sprintf("%d -> %d", aws.Int64Value(res.BatchSize), *in.BatchSize)
+	}
+
+	l.update("event source", *res.FunctionArn, m)
+	return res, nil
+}
+
+// DeleteEventSourceMapping stub.
+func (l *Lambda) DeleteEventSourceMapping(in *lambda.DeleteEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
+	res, err := l.GetEventSourceMapping(&lambda.GetEventSourceMappingInput{
+		UUID: in.UUID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	l.remove("event source", *res.FunctionArn, map[string]interface{}{
+		"source": *res.EventSourceArn,
+	})
+
+	return res, nil
+}
+
+// AddPermission stub.
+func (l *Lambda) AddPermission(in *lambda.AddPermissionInput) (*lambda.AddPermissionOutput, error) {
+	return &lambda.AddPermissionOutput{}, nil
+}
+
+// RemovePermission stub.
+func (l *Lambda) RemovePermission(in *lambda.RemovePermissionInput) (*lambda.RemovePermissionOutput, error) {
+	return &lambda.RemovePermissionOutput{}, nil
+}
+
+// TagResource stub.
+func (l *Lambda) TagResource(in *lambda.TagResourceInput) (*lambda.TagResourceOutput, error) {
+	return &lambda.TagResourceOutput{}, nil
+}
+
+// UntagResource stub.
+func (l *Lambda) UntagResource(in *lambda.UntagResourceInput) (*lambda.UntagResourceOutput, error) {
+	return &lambda.UntagResourceOutput{}, nil
+}
+
+// PutFunctionConcurrency stub.
+func (l *Lambda) PutFunctionConcurrency(in *lambda.PutFunctionConcurrencyInput) (*lambda.PutFunctionConcurrencyOutput, error) {
+	res, err := l.GetFunctionConcurrency(&lambda.GetFunctionConcurrencyInput{
+		FunctionName: in.FunctionName,
+	})
+
+	if e, ok := err.(awserr.Error); ok && e.Code() == "ResourceNotFoundException" {
+		res, err = &lambda.GetFunctionConcurrencyOutput{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res.ReservedConcurrentExecutions == nil {
+		l.create("concurrency", *in.FunctionName, map[string]interface{}{
+			"reserved": *in.ReservedConcurrentExecutions,
+		})
+	} else if *res.ReservedConcurrentExecutions != *in.ReservedConcurrentExecutions {
+		l.update("concurrency", *in.FunctionName, map[string]interface{}{
+			"reserved": fmt.Sprintf("%d -> %d", *res.ReservedConcurrentExecutions, *in.ReservedConcurrentExecutions),
+		})
+	}
+
+	return &lambda.PutFunctionConcurrencyOutput{
+		ReservedConcurrentExecutions: in.ReservedConcurrentExecutions,
+	}, nil
+}
+
+// DeleteFunctionConcurrency stub.
+func (l *Lambda) DeleteFunctionConcurrency(in *lambda.DeleteFunctionConcurrencyInput) (*lambda.DeleteFunctionConcurrencyOutput, error) {
+	l.remove("concurrency", *in.FunctionName, nil)
+	return &lambda.DeleteFunctionConcurrencyOutput{}, nil
+}
+
+// GetProvisionedConcurrencyConfig stub, functions which are
+// not yet deployed have no provisioned concurrency.
+func (l *Lambda) GetProvisionedConcurrencyConfig(in *lambda.GetProvisionedConcurrencyConfigInput) (*lambda.GetProvisionedConcurrencyConfigOutput, error) {
+	out, err := l.Lambda.GetProvisionedConcurrencyConfig(in)
+
+	if e, ok := err.(awserr.Error); ok && e.Code() == "ResourceNotFoundException" {
+		return nil, awserr.New(lambda.ErrCodeProvisionedConcurrencyConfigNotFoundException, e.Message(), nil)
+	}
+
+	return out, err
+}
+
+// PutProvisionedConcurrencyConfig stub.
+func (l *Lambda) PutProvisionedConcurrencyConfig(in *lambda.PutProvisionedConcurrencyConfigInput) (*lambda.PutProvisionedConcurrencyConfigOutput, error) {
+	l.update("provisioned concurrency", *in.FunctionName, map[string]interface{}{
+		"alias":       *in.Qualifier,
+		"provisioned": *in.ProvisionedConcurrentExecutions,
+	})
+
+	return &lambda.PutProvisionedConcurrencyConfigOutput{
+		RequestedProvisionedConcurrentExecutions: in.ProvisionedConcurrentExecutions,
+		Status:                                   aws.String(lambda.ProvisionedConcurrencyStatusEnumReady),
+	}, nil
+}
+
+// DeleteProvisionedConcurrencyConfig stub.
+func (l *Lambda) DeleteProvisionedConcurrencyConfig(in *lambda.DeleteProvisionedConcurrencyConfigInput) (*lambda.DeleteProvisionedConcurrencyConfigOutput, error) {
+	l.remove("provisioned concurrency", *in.FunctionName, map[string]interface{}{
+		"alias": *in.Qualifier,
+	})
+
+	return &lambda.DeleteProvisionedConcurrencyConfigOutput{}, nil
+}
+
+// PublishLayerVersion stub.
+func (l *Lambda) PublishLayerVersion(in *lambda.PublishLayerVersionInput) (*lambda.PublishLayerVersionOutput, error) {
+	out := &lambda.PublishLayerVersionOutput{
+		LayerVersionArn: in.LayerName,
+		Version:         aws.Int64(1),
+	}
+
+	list, err := l.ListLayerVersions(&lambda.ListLayerVersionsInput{
+		LayerName: in.LayerName,
+		MaxItems:  aws.Int64(1),
+	})
+
+	if err == nil && len(list.LayerVersions) > 0 {
+		latest := list.LayerVersions[0]
+		out.Version = aws.Int64(*latest.Version + 1)
+		arn := *latest.LayerVersionArn
+		out.LayerVersionArn = aws.String(fmt.Sprintf("%s:%d", arn[:strings.LastIndex(arn, ":")], *out.Version))
+	}
+
+	l.create("layer", *in.LayerName, map[string]interface{}{
+		"version": *out.Version,
+		"size":    humanize.Bytes(uint64(len(in.Content.ZipFile))),
+	})
+
+	return out, nil
+}
+
 func (l *Lambda) log(kind, name string, m map[string]interface{}, symbol rune, color int) {
-	fmt.Printf("  \033[%dm%c %s\033[0m \033[%dm%s\033[0m\n", color, symbol, kind, blue, name)
+	output(kind, name, m, symbol, color)
+}
+
+// output a change of `kind` to resource `name`.
+func output(kind, name string, m map[string]interface{}, symbol rune, color int) {
+	fmt.Fprintf(Writer, "  \033[%dm%c %s\033[0m \033[%dm%s\033[0m\n", color, symbol, kind, blue, name)
 	for k, v := range m {
-		fmt.Printf("    %s: %v\n", k, v)
+		fmt.Fprintf(Writer, "    %s: %v\n", k, v)
 	}
-	fmt.Printf("\n")
+	fmt.Fprintf(Writer, "\n")
 }
 
 // create message.
@@ -173,3 +447,39 @@ func (l *Lambda) update(kind, name string, m map[string]interface{}) {
 func (l *Lambda) remove(kind, name string, m map[string]interface{}) {
 	l.log(kind, name, m, '-', red)
 }
+
+// environmentChanges returns the added, changed and removed variable
+// names, omitting values as they may hold resolved secrets.
+func environmentChanges(remote *lambda.EnvironmentResponse, local *lambda.Environment) string {
+	var before, after map[string]*string
+	if remote != nil {
+		before = remote.Variables
+	}
+
+	if local != nil {
+		after = local.Variables
+	}
+
+	var changes []string
+	for k, v := range after {
+		prev, ok := before[k]
+		switch {
+		case !ok:
+			changes = append(changes, "+ "+k)
+		case aws.StringValue(prev) != aws.StringValue(v):
+			changes = append(changes, "~ "+k)
+		}
+	}
+
+	for k := range before {
+		if _, ok := after[k]; !ok {
+			changes = append(changes, "- "+k)
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i][2:] < changes[j][2:]
+	})
+
+	return strings.Join(changes, ", ")
+}
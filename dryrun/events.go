@@ -0,0 +1,62 @@
+package dryrun
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+)
+
+// CloudWatchEvents is a partially implemented CloudWatch Events API implementation used to perform a dry-run.
+type CloudWatchEvents struct {
+	*cloudwatchevents.CloudWatchEvents
+}
+
+// NewCloudWatchEvents dry-run CloudWatch Events service for the given session.
+func NewCloudWatchEvents(session *session.Session) *CloudWatchEvents {
+	return &CloudWatchEvents{
+		CloudWatchEvents: cloudwatchevents.New(session),
+	}
+}
+
+// ListRuleNamesByTarget stub, targets of functions which are
+// not yet deployed have no rules.
+func (e *CloudWatchEvents) ListRuleNamesByTarget(in *cloudwatchevents.ListRuleNamesByTargetInput) (*cloudwatchevents.ListRuleNamesByTargetOutput, error) {
+	out, err := e.CloudWatchEvents.ListRuleNamesByTarget(in)
+	if err != nil {
+		return &cloudwatchevents.ListRuleNamesByTargetOutput{}, nil
+	}
+
+	return out, nil
+}
+
+// PutRule stub.
+func (e *CloudWatchEvents) PutRule(in *cloudwatchevents.PutRuleInput) (*cloudwatchevents.PutRuleOutput, error) {
+	m := map[string]interface{}{
+		"expression": *in.ScheduleExpression,
+		"state":      *in.State,
+	}
+
+	if _, err := e.DescribeRule(&cloudwatchevents.DescribeRuleInput{Name: in.Name}); err == nil {
+		output("schedule", *in.Name, m, '~', yellow)
+	} else {
+		output("schedule", *in.Name, m, '+', green)
+	}
+
+	return &cloudwatchevents.PutRuleOutput{RuleArn: aws.String("")}, nil
+}
+
+// PutTargets stub.
+func (e *CloudWatchEvents) PutTargets(in *cloudwatchevents.PutTargetsInput) (*cloudwatchevents.PutTargetsOutput, error) {
+	return &cloudwatchevents.PutTargetsOutput{}, nil
+}
+
+// RemoveTargets stub.
+func (e *CloudWatchEvents) RemoveTargets(in *cloudwatchevents.RemoveTargetsInput) (*cloudwatchevents.RemoveTargetsOutput, error) {
+	return &cloudwatchevents.RemoveTargetsOutput{}, nil
+}
+
+// DeleteRule stub.
+func (e *CloudWatchEvents) DeleteRule(in *cloudwatchevents.DeleteRuleInput) (*cloudwatchevents.DeleteRuleOutput, error) {
+	output("schedule", *in.Name, nil, '-', red)
+	return &cloudwatchevents.DeleteRuleOutput{}, nil
+}
@@ -0,0 +1,33 @@
+package dryrun
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/dustin/go-humanize"
+)
+
+// S3 is a partially implemented S3 API implementation used to perform a dry-run.
+type S3 struct {
+	*s3.S3
+}
+
+// NewS3 dry-run S3 service for the given session.
+func NewS3(session *session.Session) *S3 {
+	return &S3{
+		S3: s3.New(session),
+	}
+}
+
+// PutObject stub.
+func (s *S3) PutObject(in *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	size, _ := in.Body.Seek(0, io.SeekEnd)
+
+	output("s3 object", fmt.Sprintf("s3://%s/%s", *in.Bucket, *in.Key), map[string]interface{}{
+		"size": humanize.Bytes(uint64(size)),
+	}, '+', green)
+
+	return &s3.PutObjectOutput{}, nil
+}
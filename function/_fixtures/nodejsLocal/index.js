@@ -0,0 +1,12 @@
+exports.handle = function(e, ctx, cb) {
+  console.log('processing %s', e.value)
+  if (e.fail) return cb(new Error('boom'))
+  if (e.hang) return
+  if (e.grow) return grow([])
+  cb(null, { value: e.value.toUpperCase() })
+}
+
+function grow(list) {
+  list.push(Buffer.alloc(16 << 20, 1))
+  setTimeout(grow, 10, list)
+}
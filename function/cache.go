@@ -0,0 +1,93 @@
+package function
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/apex/apex/utils"
+)
+
+// cacheKey returns the key of the function's build, the SHA256 of the
+// files included after ignore rules, the hook commands and the
+// configuration which affects the zip produced by plugins.
+func (f *Function) cacheKey() (string, error) {
+	paths, err := utils.LoadFiles(f.Path, f.IgnoreFile)
+	if err != nil {
+		return "", err
+	}
+
+	sort.Strings(paths)
+
+	h := sha256.New()
+	fmt.Fprintf(h, "runtime=%s\nhandler=%s\nshim=%t\n", f.Runtime, f.Handler, f.Shim)
+	fmt.Fprintf(h, "prebuild=%q\nbuild=%q\npostbuild=%q\nclean=%q\n", f.Hooks.PreBuild, f.Hooks.Build, f.Hooks.PostBuild, f.Hooks.Clean)
+	fmt.Fprintf(h, "plugins=%q\n", f.Plugins)
+
+	for _, path := range paths {
+		info, err := os.Stat(filepath.Join(f.Path, path))
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(h, "%s %s\n", filepath.ToSlash(path), info.Mode())
+
+		file, err := os.Open(filepath.Join(f.Path, path))
+		if err != nil {
+			return "", err
+		}
+
+		_, err = io.Copy(h, file)
+		file.Close()
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// cachePath returns the path of the cached build for `key`.
+func (f *Function) cachePath(key string) string {
+	return filepath.Join(f.BuildCache, key+".zip")
+}
+
+// cached returns the cached build for `key`, or nil when missing.
+func (f *Function) cached(key string) []byte {
+	b, err := ioutil.ReadFile(f.cachePath(key))
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
+
+// cache stores the build `zip` for `key`.
+func (f *Function) cache(key string, zip []byte) error {
+	if err := os.MkdirAll(f.BuildCache, 0755); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(f.BuildCache, key)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(zip); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), f.cachePath(key))
+}
@@ -0,0 +1,60 @@
+package function_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/hooks"
+)
+
+func TestFunction_Build_cache(t *testing.T) {
+	src, err := ioutil.TempDir("", "apex-src")
+	assert.NoError(t, err)
+	defer os.RemoveAll(src)
+
+	cache, err := ioutil.TempDir("", "apex-cache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(cache)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "index.js"), []byte("exports.handle = () => {}"), 0644))
+
+	fn := &function.Function{
+		Path:       src,
+		Log:        log.Log,
+		BuildCache: cache,
+		Plugins:    []string{"hooks"},
+		Config: function.Config{
+			Runtime: "nodejs",
+			Hooks: hooks.Hooks{
+				Build: hooks.Command{"echo build >> ../" + filepath.Base(src) + ".log"},
+			},
+		},
+	}
+
+	builds := func() int {
+		b, _ := ioutil.ReadFile(src + ".log")
+		return len(b) / len("build\n")
+	}
+	defer os.Remove(src + ".log")
+
+	first, err := fn.BuildBytes()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, builds())
+
+	second, err := fn.BuildBytes()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, builds(), "hooks are skipped when unchanged")
+	assert.Equal(t, first, second)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "index.js"), []byte("exports.handle = () => 1"), 0644))
+
+	_, err = fn.BuildBytes()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, builds(), "changed files are rebuilt")
+}
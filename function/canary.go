@@ -0,0 +1,190 @@
+package function
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/pkg/errors"
+
+	"github.com/apex/apex/metrics"
+)
+
+// DefaultCanaryInterval is the default interval between canary metric checks.
+const DefaultCanaryInterval = 30 * time.Second
+
+// Canary configures a weighted canary deploy, routing a share of the alias
+// traffic to the new version for the duration of the bake window before
+// promoting it to 100%, or reverting the alias on errors or throttles.
+type Canary struct {
+	// Weight is the share of traffic (0-1) routed to the new version.
+	Weight float64
+
+	// Bake is the duration the new version is observed before promotion.
+	Bake time.Duration
+
+	// Interval between metric checks, defaulting to DefaultCanaryInterval.
+	Interval time.Duration
+
+	// Threshold is the number of errors and throttles tolerated.
+	Threshold int
+
+	// RequireInvocations reverts a version which is not invoked during the
+	// bake window, otherwise it's promoted with a warning.
+	RequireInvocations bool
+
+	// Metrics is the CloudWatch service used to observe the new version,
+	// which must be in the region of the function.
+	Metrics cloudwatchiface.CloudWatchAPI
+}
+
+// CanaryError records a failed canary.
+type CanaryError struct {
+	Version   string
+	Errors    int
+	Throttles int
+}
+
+// Error message.
+func (e *CanaryError) Error() string {
+	return fmt.Sprintf("canary version %s failed with %d errors and %d throttles", e.Version, e.Errors, e.Throttles)
+}
+
+// DeployCanary routes the configured share of `f.Alias` traffic to `version`,
+// bakes it and then either promotes it or reverts the alias to the previous
+// version. When the alias does not exist yet it is simply pointed at `version`.
+func (f *Function) DeployCanary(version string) error {
+	// Alias routing only applies to published versions, which is
+	// also the case for dry-runs where nothing is published.
+	if version == "$LATEST" {
+		return f.CreateOrUpdateAlias(f.Alias, version)
+	}
+
+	alias, err := f.currentVersionAlias()
+
+	if e, ok := err.(awserr.Error); ok && e.Code() == "ResourceNotFoundException" {
+		return f.CreateOrUpdateAlias(f.Alias, version)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	prev := *alias.FunctionVersion
+	if prev == version {
+		return f.CreateOrUpdateAlias(f.Alias, version)
+	}
+
+	f.Log.WithFields(log.Fields{
+		"version":  version,
+		"previous": prev,
+		"weight":   fmt.Sprintf("%.0f%%", f.Canary.Weight*100),
+	}).Info("starting canary")
+
+	if err := f.routeAlias(prev, version, f.Canary.Weight); err != nil {
+		return errors.Wrap(err, "routing canary")
+	}
+
+	if err := f.bakeCanary(version); err != nil {
+		f.Log.WithError(err).Warnf("reverting alias %s to version %s", f.Alias, prev)
+
+		if err := f.routeAlias(prev, "", 0); err != nil {
+			return errors.Wrap(err, "reverting canary")
+		}
+
+		return err
+	}
+
+	if err := f.routeAlias(version, "", 0); err != nil {
+		return errors.Wrap(err, "promoting canary")
+	}
+
+	f.Log.WithField("version", version).Infof("promoted alias %s", f.Alias)
+	return nil
+}
+
+// routeAlias points the alias at `version`, routing `weight` of the
+// traffic to `canary`, or clearing the routing when `canary` is empty.
+func (f *Function) routeAlias(version, canary string, weight float64) error {
+	routing := &lambda.AliasRoutingConfiguration{
+		AdditionalVersionWeights: map[string]*float64{},
+	}
+
+	if canary != "" {
+		routing.AdditionalVersionWeights[canary] = aws.Float64(weight)
+	}
+
+	_, err := f.Service.UpdateAlias(&lambda.UpdateAliasInput{
+		FunctionName:    &f.FunctionName,
+		FunctionVersion: &version,
+		Name:            &f.Alias,
+		RoutingConfig:   routing,
+	})
+
+	return err
+}
+
+// bakeCanary observes errors and throttles of `version` until the bake
+// window elapses, returning a CanaryError when the threshold is exceeded,
+// or an error when the metrics can't be collected. A version which is not
+// invoked is only reverted when invocations are required.
+func (f *Function) bakeCanary(version string) error {
+	interval := f.Canary.Interval
+	if interval == 0 {
+		interval = DefaultCanaryInterval
+	}
+
+	start := time.Now().UTC().Truncate(time.Minute)
+	deadline := time.Now().Add(f.Canary.Bake)
+
+	for {
+		m := metrics.Metric{
+			Config: metrics.Config{
+				MetricNames: []string{"Invocations", "Errors", "Throttles"},
+				Service:     f.Canary.Metrics,
+				StartDate:   start,
+				EndDate:     time.Now().UTC(),
+			},
+			FunctionName:    f.FunctionName,
+			Resource:        fmt.Sprintf("%s:%s", f.FunctionName, f.Alias),
+			ExecutedVersion: version,
+		}
+
+		a, err := m.Collect()
+		if err != nil {
+			return errors.Wrap(err, "collecting metrics")
+		}
+
+		f.Log.WithFields(log.Fields{
+			"invocations": a.Invocations,
+			"errors":      a.Errors,
+			"throttles":   a.Throttles,
+		}).Debug("canary metrics")
+
+		if a.Errors+a.Throttles > f.Canary.Threshold {
+			return &CanaryError{
+				Version:   version,
+				Errors:    a.Errors,
+				Throttles: a.Throttles,
+			}
+		}
+
+		if !time.Now().Before(deadline) {
+			if a.Invocations == 0 && f.Canary.RequireInvocations {
+				return errors.Errorf("canary version %s received no invocations", version)
+			}
+
+			if a.Invocations == 0 {
+				f.Log.Warnf("canary version %s received no invocations", version)
+			}
+
+			return nil
+		}
+
+		time.Sleep(interval)
+	}
+}
@@ -0,0 +1,220 @@
+package function_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/mock"
+)
+
+// fakeCloudWatch returns the sum of each metric in `sums`,
+// and no datapoints for the other metrics requested.
+type fakeCloudWatch struct {
+	cloudwatchiface.CloudWatchAPI
+	sums map[string]float64
+}
+
+func (f *fakeCloudWatch) GetMetricData(in *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+	out := &cloudwatch.GetMetricDataOutput{}
+
+	for _, q := range in.MetricDataQueries {
+		r := &cloudwatch.MetricDataResult{Id: q.Id}
+
+		if sum, ok := f.sums[*q.MetricStat.Metric.MetricName]; ok {
+			r.Timestamps = []*time.Time{in.StartTime}
+			r.Values = []*float64{aws.Float64(sum)}
+		}
+
+		out.MetricDataResults = append(out.MetricDataResults, r)
+	}
+
+	return out, nil
+}
+
+func TestFunction_DeployCanary_promote(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{FunctionVersion: aws.String("1")}, nil)
+	gomock.InOrder(
+		serviceMock.EXPECT().UpdateAlias(&lambda.UpdateAliasInput{
+			FunctionName:    aws.String("testfn"),
+			Name:            aws.String("current"),
+			FunctionVersion: aws.String("1"),
+			RoutingConfig: &lambda.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]*float64{"2": aws.Float64(0.1)},
+			},
+		}),
+		serviceMock.EXPECT().UpdateAlias(&lambda.UpdateAliasInput{
+			FunctionName:    aws.String("testfn"),
+			Name:            aws.String("current"),
+			FunctionVersion: aws.String("2"),
+			RoutingConfig: &lambda.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]*float64{},
+			},
+		}),
+	)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Canary: &function.Canary{
+			Weight: 0.1,
+			Metrics: &fakeCloudWatch{sums: map[string]float64{
+				"Invocations": 10,
+				"Errors":      0,
+			}},
+		},
+	}
+
+	assert.Nil(t, fn.DeployCanary("2"))
+}
+
+func TestFunction_DeployCanary_revert(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{FunctionVersion: aws.String("1")}, nil)
+	gomock.InOrder(
+		serviceMock.EXPECT().UpdateAlias(gomock.Any()),
+		serviceMock.EXPECT().UpdateAlias(&lambda.UpdateAliasInput{
+			FunctionName:    aws.String("testfn"),
+			Name:            aws.String("current"),
+			FunctionVersion: aws.String("1"),
+			RoutingConfig: &lambda.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]*float64{},
+			},
+		}),
+	)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Canary: &function.Canary{
+			Weight: 0.1,
+			Metrics: &fakeCloudWatch{sums: map[string]float64{
+				"Invocations": 10,
+				"Errors":      3,
+				"Throttles":   3,
+			}},
+		},
+	}
+
+	err := fn.DeployCanary("2")
+
+	assert.EqualError(t, err, "canary version 2 failed with 3 errors and 3 throttles")
+}
+
+func TestFunction_DeployCanary_requireInvocations(t *testing.T) {
+	cases := map[string]map[string]float64{
+		"missing": nil,
+		"empty":   {"Invocations": 0, "Errors": 0, "Throttles": 0},
+	}
+
+	for name, sums := range cases {
+		t.Run(name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+			serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{FunctionVersion: aws.String("1")}, nil)
+			gomock.InOrder(
+				serviceMock.EXPECT().UpdateAlias(gomock.Any()),
+				serviceMock.EXPECT().UpdateAlias(&lambda.UpdateAliasInput{
+					FunctionName:    aws.String("testfn"),
+					Name:            aws.String("current"),
+					FunctionVersion: aws.String("1"),
+					RoutingConfig: &lambda.AliasRoutingConfiguration{
+						AdditionalVersionWeights: map[string]*float64{},
+					},
+				}),
+			)
+
+			fn := &function.Function{
+				FunctionName: "testfn",
+				Alias:        "current",
+				Service:      serviceMock,
+				Log:          log.Log,
+				Canary: &function.Canary{
+					Weight:             0.1,
+					Metrics:            &fakeCloudWatch{sums: sums},
+					RequireInvocations: true,
+				},
+			}
+
+			err := fn.DeployCanary("2")
+
+			assert.EqualError(t, err, "canary version 2 received no invocations")
+		})
+	}
+}
+
+func TestFunction_DeployCanary_samePreviousVersion(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{FunctionVersion: aws.String("2")}, nil)
+	serviceMock.EXPECT().CreateAlias(&lambda.CreateAliasInput{
+		FunctionName:    aws.String("testfn"),
+		FunctionVersion: aws.String("2"),
+		Name:            aws.String("current"),
+	}).Return(&lambda.AliasConfiguration{}, nil)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Canary:       &function.Canary{Weight: 0.1},
+	}
+
+	assert.Nil(t, fn.DeployCanary("2"))
+}
+
+func TestFunction_DeployCanary_noInvocations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{FunctionVersion: aws.String("1")}, nil)
+	gomock.InOrder(
+		serviceMock.EXPECT().UpdateAlias(gomock.Any()),
+		serviceMock.EXPECT().UpdateAlias(&lambda.UpdateAliasInput{
+			FunctionName:    aws.String("testfn"),
+			Name:            aws.String("current"),
+			FunctionVersion: aws.String("2"),
+			RoutingConfig: &lambda.AliasRoutingConfiguration{
+				AdditionalVersionWeights: map[string]*float64{},
+			},
+		}),
+	)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Canary: &function.Canary{
+			Weight:  0.1,
+			Metrics: &fakeCloudWatch{},
+		},
+	}
+
+	assert.Nil(t, fn.DeployCanary("2"))
+}
@@ -0,0 +1,152 @@
+package function
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+// provisionedInterval is the interval between provisioned concurrency status checks.
+const provisionedInterval = 5 * time.Second
+
+// reservedConcurrency returns the configured reserved concurrency,
+// or nil when omitted or zero, which removes it.
+func (f *Function) reservedConcurrency() *int64 {
+	if f.ReservedConcurrency == nil || *f.ReservedConcurrency == 0 {
+		return nil
+	}
+
+	return f.ReservedConcurrency
+}
+
+// putReservedConcurrency applies the reserved concurrency, removing
+// the reserved concurrency of the function when no longer configured.
+func (f *Function) putReservedConcurrency() error {
+	reserved := f.reservedConcurrency()
+
+	if reserved == nil {
+		if !f.reserved {
+			return nil
+		}
+
+		f.Log.Info("removing reserved concurrency")
+
+		_, err := f.Service.DeleteFunctionConcurrency(&lambda.DeleteFunctionConcurrencyInput{
+			FunctionName: &f.FunctionName,
+		})
+
+		return err
+	}
+
+	f.Log.WithField("reserved", *reserved).Debug("updating reserved concurrency")
+
+	_, err := f.Service.PutFunctionConcurrency(&lambda.PutFunctionConcurrencyInput{
+		FunctionName:                 &f.FunctionName,
+		ReservedConcurrentExecutions: reserved,
+	})
+
+	return err
+}
+
+// DeployProvisionedConcurrency applies the provisioned concurrency of the
+// function's alias, when configured, and waits until it is ready. A value
+// of zero removes the provisioned concurrency.
+func (f *Function) DeployProvisionedConcurrency() error {
+	if f.ProvisionedConcurrency == nil {
+		return nil
+	}
+
+	want := *f.ProvisionedConcurrency
+	log := f.Log.WithFields(log.Fields{
+		"alias":       f.Alias,
+		"provisioned": want,
+	})
+
+	current, err := f.Service.GetProvisionedConcurrencyConfig(&lambda.GetProvisionedConcurrencyConfigInput{
+		FunctionName: &f.FunctionName,
+		Qualifier:    &f.Alias,
+	})
+
+	e, ok := err.(awserr.Error)
+	notFound := ok && e.Code() == lambda.ErrCodeProvisionedConcurrencyConfigNotFoundException
+
+	if err != nil && !notFound {
+		return err
+	}
+
+	if want == 0 {
+		if notFound {
+			return nil
+		}
+
+		log.Info("removing provisioned concurrency")
+		_, err := f.Service.DeleteProvisionedConcurrencyConfig(&lambda.DeleteProvisionedConcurrencyConfigInput{
+			FunctionName: &f.FunctionName,
+			Qualifier:    &f.Alias,
+		})
+
+		return err
+	}
+
+	var status *string
+	if !notFound {
+		status = current.Status
+	}
+
+	if notFound || *current.RequestedProvisionedConcurrentExecutions != want {
+		log.Info("updating provisioned concurrency")
+
+		updated, err := f.Service.PutProvisionedConcurrencyConfig(&lambda.PutProvisionedConcurrencyConfigInput{
+			FunctionName:                    &f.FunctionName,
+			Qualifier:                       &f.Alias,
+			ProvisionedConcurrentExecutions: &want,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		status = updated.Status
+	}
+
+	if aws.StringValue(status) == lambda.ProvisionedConcurrencyStatusEnumReady {
+		return nil
+	}
+
+	return f.waitProvisionedConcurrency()
+}
+
+// waitProvisionedConcurrency polls the provisioned concurrency of the alias until it is ready.
+func (f *Function) waitProvisionedConcurrency() error {
+	f.Log.Info("waiting for provisioned concurrency")
+
+	for {
+		c, err := f.Service.GetProvisionedConcurrencyConfig(&lambda.GetProvisionedConcurrencyConfigInput{
+			FunctionName: &f.FunctionName,
+			Qualifier:    &f.Alias,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		switch *c.Status {
+		case lambda.ProvisionedConcurrencyStatusEnumReady:
+			f.Log.Info("provisioned concurrency ready")
+			return nil
+		case lambda.ProvisionedConcurrencyStatusEnumFailed:
+			return fmt.Errorf("provisioned concurrency failed: %s", aws.StringValue(c.StatusReason))
+		}
+
+		f.Log.WithFields(log.Fields{
+			"allocated": aws.Int64Value(c.AllocatedProvisionedConcurrentExecutions),
+			"requested": aws.Int64Value(c.RequestedProvisionedConcurrentExecutions),
+		}).Debug("provisioned concurrency in progress")
+
+		time.Sleep(provisionedInterval)
+	}
+}
@@ -0,0 +1,154 @@
+package function_test
+
+import (
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/mock"
+)
+
+func TestFunction_DeployProvisionedConcurrency_create(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	input := &lambda.GetProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String("testfn"),
+		Qualifier:    aws.String("current"),
+	}
+
+	gomock.InOrder(
+		serviceMock.EXPECT().GetProvisionedConcurrencyConfig(input).Return(nil, awserr.New(lambda.ErrCodeProvisionedConcurrencyConfigNotFoundException, "not found", nil)),
+		serviceMock.EXPECT().PutProvisionedConcurrencyConfig(&lambda.PutProvisionedConcurrencyConfigInput{
+			FunctionName:                    aws.String("testfn"),
+			Qualifier:                       aws.String("current"),
+			ProvisionedConcurrentExecutions: aws.Int64(5),
+		}).Return(&lambda.PutProvisionedConcurrencyConfigOutput{Status: aws.String("IN_PROGRESS")}, nil),
+		serviceMock.EXPECT().GetProvisionedConcurrencyConfig(input).Return(&lambda.GetProvisionedConcurrencyConfigOutput{Status: aws.String("READY")}, nil),
+	)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Config: function.Config{
+			ProvisionedConcurrency: aws.Int64(5),
+		},
+	}
+
+	assert.Nil(t, fn.DeployProvisionedConcurrency())
+}
+
+func TestFunction_DeployProvisionedConcurrency_failed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	gomock.InOrder(
+		serviceMock.EXPECT().GetProvisionedConcurrencyConfig(gomock.Any()).Return(&lambda.GetProvisionedConcurrencyConfigOutput{
+			RequestedProvisionedConcurrentExecutions: aws.Int64(5),
+			Status:                                   aws.String("IN_PROGRESS"),
+		}, nil),
+		serviceMock.EXPECT().GetProvisionedConcurrencyConfig(gomock.Any()).Return(&lambda.GetProvisionedConcurrencyConfigOutput{
+			Status:       aws.String("FAILED"),
+			StatusReason: aws.String("insufficient capacity"),
+		}, nil),
+	)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Config: function.Config{
+			ProvisionedConcurrency: aws.Int64(5),
+		},
+	}
+
+	assert.EqualError(t, fn.DeployProvisionedConcurrency(), "provisioned concurrency failed: insufficient capacity")
+}
+
+func TestFunction_DeployProvisionedConcurrency_remove(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().GetProvisionedConcurrencyConfig(gomock.Any()).Return(&lambda.GetProvisionedConcurrencyConfigOutput{
+		RequestedProvisionedConcurrentExecutions: aws.Int64(5),
+		Status:                                   aws.String("READY"),
+	}, nil)
+	serviceMock.EXPECT().DeleteProvisionedConcurrencyConfig(&lambda.DeleteProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String("testfn"),
+		Qualifier:    aws.String("current"),
+	})
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Config: function.Config{
+			ProvisionedConcurrency: aws.Int64(0),
+		},
+	}
+
+	assert.Nil(t, fn.DeployProvisionedConcurrency())
+}
+
+func TestFunction_Deploy_removeReservedConcurrency(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().GetFunction(gomock.Any()).Return(&lambda.GetFunctionOutput{
+		Configuration: &lambda.FunctionConfiguration{
+			CodeSha256:  aws.String("sha"),
+			Description: aws.String(""),
+			MemorySize:  aws.Int64(128),
+			Timeout:     aws.Int64(3),
+			Role:        aws.String("iamrole"),
+			Runtime:     aws.String("nodejs"),
+			Handler:     aws.String("index.handle"),
+		},
+		Concurrency: &lambda.PutFunctionConcurrencyOutput{
+			ReservedConcurrentExecutions: aws.Int64(10),
+		},
+	}, nil)
+	serviceMock.EXPECT().UpdateFunctionConfiguration(gomock.Any())
+	serviceMock.EXPECT().WaitUntilFunctionUpdated(gomock.Any()).Times(2)
+	serviceMock.EXPECT().DeleteFunctionConcurrency(&lambda.DeleteFunctionConcurrencyInput{
+		FunctionName: aws.String("testfn"),
+	})
+	serviceMock.EXPECT().UpdateFunctionCode(gomock.Any()).Return(&lambda.FunctionConfiguration{}, nil)
+	serviceMock.EXPECT().PublishVersion(gomock.Any()).Return(&lambda.FunctionConfiguration{Version: aws.String("2")}, nil)
+	serviceMock.EXPECT().CreateAlias(gomock.Any())
+	serviceMock.EXPECT().ListVersionsByFunction(gomock.Any()).Return(&lambda.ListVersionsByFunctionOutput{
+		Versions: []*lambda.FunctionConfiguration{{}},
+	}, nil)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Config: function.Config{
+			Memory:           128,
+			Timeout:          3,
+			Role:             "iamrole",
+			Runtime:          "nodejs",
+			Handler:          "index.handle",
+			RetainedVersions: aws.Int(1),
+			Environment:      make(map[string]string),
+		},
+	}
+
+	assert.Nil(t, fn.DeployZip([]byte("zip")))
+}
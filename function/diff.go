@@ -0,0 +1,165 @@
+package function
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/dustin/go-humanize"
+
+	"github.com/apex/apex/utils"
+)
+
+// masked replaces environment variable values in diffs.
+const masked = "********"
+
+// Change is a single field which differs between the local
+// configuration and the configuration stored in AWS Lambda.
+type Change struct {
+	Field  string `json:"field"`
+	Local  string `json:"local"`
+	Remote string `json:"remote"`
+}
+
+// Diff is the difference between the local function and the deployed function.
+type Diff struct {
+	Name         string   `json:"name"`
+	FunctionName string   `json:"function_name"`
+	Region       string   `json:"region,omitempty"`
+	Created      bool     `json:"created"`
+	Changes      []Change `json:"changes"`
+}
+
+// Drift returns true when the function would be changed by a deploy.
+func (d *Diff) Drift() bool {
+	return d.Created || len(d.Changes) > 0
+}
+
+// Diff builds the function and compares its configuration and code against
+// the deployed function. Environment variable values are masked.
+func (f *Function) Diff() (*Diff, error) {
+	d := &Diff{
+		Name:         f.Name,
+		FunctionName: f.FunctionName,
+		Region:       f.Region,
+	}
+
+	zip, err := f.ZipBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.ResolveSecrets(); err != nil {
+		return nil, err
+	}
+
+	config, err := f.GetConfig()
+
+	if e, ok := err.(awserr.Error); ok && e.Code() == "ResourceNotFoundException" {
+		d.Created = true
+		return d, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	local := f.localConfig()
+	remote := f.remoteConfig(config)
+
+	d.add("description", local.Description, remote.Description)
+	d.add("runtime", local.Runtime, remote.Runtime)
+	d.add("memory", fmt.Sprintf("%d", local.Memory), fmt.Sprintf("%d", remote.Memory))
+	d.add("timeout", fmt.Sprintf("%d", local.Timeout), fmt.Sprintf("%d", remote.Timeout))
+	d.add("role", local.Role, remote.Role)
+	d.add("handler", local.Handler, remote.Handler)
+	d.addEnvironment(local.Environment, remote.Environment)
+	d.add("vpc.subnets", strings.Join(local.VPC.Subnets, ", "), strings.Join(remote.VPC.Subnets, ", "))
+	d.add("vpc.securityGroups", strings.Join(local.VPC.SecurityGroups, ", "), strings.Join(remote.VPC.SecurityGroups, ", "))
+	d.add("deadletter_arn", aws.StringValue(local.DeadLetterConfig.TargetArn), aws.StringValue(remote.DeadLetterConfig.TargetArn))
+	d.add("kms_arn", local.KMSKeyArn, remote.KMSKeyArn)
+	d.add("layers", strings.Join(local.Layers, ", "), strings.Join(remote.Layers, ", "))
+	d.add("architecture", local.Architecture, remote.Architecture)
+	d.add("reservedConcurrency", count(local.ReservedConcurrency), count(remote.ReservedConcurrency))
+
+	localCode := fmt.Sprintf("%s (%s)", utils.Sha256(zip), humanize.Bytes(uint64(len(zip))))
+	remoteCode := fmt.Sprintf("%s (%s)", *config.Configuration.CodeSha256, humanize.Bytes(uint64(*config.Configuration.CodeSize)))
+
+	if utils.Sha256(zip) != *config.Configuration.CodeSha256 {
+		d.add("code", localCode, remoteCode)
+	}
+
+	return d, nil
+}
+
+// add a change when `local` and `remote` differ.
+func (d *Diff) add(field, local, remote string) {
+	if local != remote {
+		d.Changes = append(d.Changes, Change{
+			Field:  field,
+			Local:  local,
+			Remote: remote,
+		})
+	}
+}
+
+// addEnvironment adds masked changes for the given sorted KEY=VALUE pairs.
+func (d *Diff) addEnvironment(local, remote []string) {
+	l := pairs(local)
+	r := pairs(remote)
+
+	var keys []string
+	for k := range l {
+		keys = append(keys, k)
+	}
+
+	for k := range r {
+		if _, ok := l[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		lv, lok := l[k]
+		rv, rok := r[k]
+
+		if lok && rok && lv == rv {
+			continue
+		}
+
+		c := Change{Field: "environment." + k}
+
+		if lok {
+			c.Local = masked
+		}
+
+		if rok {
+			c.Remote = masked
+		}
+
+		d.Changes = append(d.Changes, c)
+	}
+}
+
+// pairs returns a map of KEY=VALUE pairs.
+func pairs(env []string) map[string]string {
+	m := make(map[string]string)
+	for _, pair := range env {
+		parts := strings.SplitN(pair, "=", 2)
+		m[parts[0]] = parts[1]
+	}
+	return m
+}
+
+// count returns the formatted `n`, or an empty string when nil.
+func count(n *int64) string {
+	if n == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%d", *n)
+}
@@ -0,0 +1,172 @@
+package function_test
+
+import (
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/mock"
+	"github.com/apex/apex/utils"
+)
+
+func TestFunction_Diff_changes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().GetFunction(gomock.Any()).Return(&lambda.GetFunctionOutput{
+		Configuration: &lambda.FunctionConfiguration{
+			Description: aws.String(""),
+			MemorySize:  aws.Int64(128),
+			Timeout:     aws.Int64(3),
+			Role:        aws.String("role"),
+			Runtime:     aws.String("nodejs6.10"),
+			Handler:     aws.String("index.handle"),
+			CodeSha256:  aws.String(utils.Sha256(nil)),
+			CodeSize:    aws.Int64(0),
+			Environment: &lambda.EnvironmentResponse{
+				Variables: map[string]*string{
+					"SECRET":  aws.String("old"),
+					"REMOVED": aws.String("value"),
+				},
+			},
+		},
+	}, nil)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Name:         "test",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Config: function.Config{
+			Memory:      512,
+			Timeout:     3,
+			Role:        "role",
+			Runtime:     "nodejs6.10",
+			Handler:     "index.handle",
+			Zip:         "_fixtures/nodejsDefaultFile/index.js",
+			Environment: map[string]string{"SECRET": "new"},
+		},
+	}
+
+	d, err := fn.Diff()
+	assert.NoError(t, err)
+
+	assert.True(t, d.Drift())
+	assert.Equal(t, []function.Change{
+		{Field: "memory", Local: "512", Remote: "128"},
+		{Field: "environment.REMOVED", Local: "", Remote: "********"},
+		{Field: "environment.SECRET", Local: "********", Remote: "********"},
+	}, d.Changes)
+}
+
+func TestFunction_Diff_reservedConcurrency(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().GetFunction(gomock.Any()).Return(&lambda.GetFunctionOutput{
+		Configuration: &lambda.FunctionConfiguration{
+			Description: aws.String(""),
+			MemorySize:  aws.Int64(128),
+			Timeout:     aws.Int64(3),
+			Role:        aws.String("role"),
+			Runtime:     aws.String("nodejs6.10"),
+			Handler:     aws.String("index.handle"),
+			CodeSha256:  aws.String(utils.Sha256(nil)),
+			CodeSize:    aws.Int64(0),
+		},
+		Concurrency: &lambda.PutFunctionConcurrencyOutput{
+			ReservedConcurrentExecutions: aws.Int64(10),
+		},
+	}, nil)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Name:         "test",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Config: function.Config{
+			Memory:  128,
+			Timeout: 3,
+			Role:    "role",
+			Runtime: "nodejs6.10",
+			Handler: "index.handle",
+			Zip:     "_fixtures/nodejsDefaultFile/index.js",
+		},
+	}
+
+	d, err := fn.Diff()
+	assert.NoError(t, err)
+	assert.Contains(t, d.Changes, function.Change{Field: "reservedConcurrency", Local: "", Remote: "10"})
+}
+
+func TestFunction_Diff_notDeployed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().GetFunction(gomock.Any()).Return(nil, awserr.New("ResourceNotFoundException", "message", nil))
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Config: function.Config{
+			Zip: "_fixtures/nodejsDefaultFile/index.js",
+		},
+	}
+
+	d, err := fn.Diff()
+	assert.NoError(t, err)
+	assert.True(t, d.Created)
+	assert.True(t, d.Drift())
+}
+
+func TestFunction_Diff_architecture(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().GetFunction(gomock.Any()).Return(&lambda.GetFunctionOutput{
+		Configuration: &lambda.FunctionConfiguration{
+			Description: aws.String(""),
+			MemorySize:  aws.Int64(128),
+			Timeout:     aws.Int64(3),
+			Role:        aws.String("role"),
+			Runtime:     aws.String("nodejs6.10"),
+			Handler:     aws.String("index.handle"),
+			CodeSha256:  aws.String(utils.Sha256(nil)),
+			CodeSize:    aws.Int64(0),
+		},
+	}, nil)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Name:         "test",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Config: function.Config{
+			Memory:       128,
+			Timeout:      3,
+			Role:         "role",
+			Runtime:      "nodejs6.10",
+			Handler:      "index.handle",
+			Zip:          "_fixtures/nodejsDefaultFile/index.js",
+			Architecture: "arm64",
+		},
+	}
+
+	d, err := fn.Diff()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []function.Change{
+		{Field: "architecture", Local: "arm64", Remote: "x86_64"},
+	}, d.Changes)
+}
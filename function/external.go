@@ -0,0 +1,255 @@
+package function
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/apex/apex/archive"
+)
+
+// ExternalPrefix is the prefix of plugin executables discovered in PATH.
+const ExternalPrefix = "apex-plugin-"
+
+// Hooks of the external plugin protocol.
+const (
+	hookDescribe = "describe"
+	hookOpen     = "open"
+	hookBuild    = "build"
+	hookClean    = "clean"
+	hookDeploy   = "deploy"
+)
+
+// External is a plugin executable driven over a JSON protocol. For each
+// hook a request is written to its stdin and a response read from its
+// stdout, while its stderr is passed through. The "describe" hook is
+// requested once, responding with the hooks the plugin reacts to.
+type External struct {
+	Name    string
+	Command string
+
+	once  sync.Once
+	hooks map[string]bool
+	err   error
+}
+
+// ExternalRequest is written to the stdin of external plugins.
+type ExternalRequest struct {
+	Hook     string            `json:"hook"`
+	Function *ExternalFunction `json:"function,omitempty"`
+}
+
+// ExternalFunction is the function a hook is requested for.
+type ExternalFunction struct {
+	Name         string `json:"name"`
+	FunctionName string `json:"function_name"`
+	Path         string `json:"path"`
+	Config       Config `json:"config"`
+}
+
+// ExternalResponse is read from the stdout of external plugins.
+type ExternalResponse struct {
+	// Hooks the plugin reacts to, in response to "describe".
+	Hooks []string `json:"hooks,omitempty"`
+
+	// Config replaces the function's config, in response to "open" or "deploy".
+	Config *Config `json:"config,omitempty"`
+
+	// Files are added to the zip, in response to "build".
+	Files []ExternalFile `json:"files,omitempty"`
+
+	// Error fails the hook.
+	Error string `json:"error,omitempty"`
+}
+
+// ExternalFile is a file added to the zip by an external plugin.
+type ExternalFile struct {
+	Path    string `json:"path"`
+	Content []byte `json:"content"`
+}
+
+// Externals which have been resolved.
+var externals struct {
+	sync.Mutex
+	commands map[string]string
+}
+
+// RegisterExternalPlugin registers the plugin executable `command` by `name`,
+// taking precedence over executables discovered in PATH.
+func RegisterExternalPlugin(name, command string) {
+	externals.Lock()
+	defer externals.Unlock()
+
+	if externals.commands == nil {
+		externals.commands = make(map[string]string)
+	}
+
+	externals.commands[name] = command
+
+	pluginsMu.Lock()
+	delete(plugins, name)
+	pluginsMu.Unlock()
+}
+
+// DefaultPlugins returns the names of the default plugins in order.
+func DefaultPlugins() []string {
+	return append([]string(nil), defaultPlugins...)
+}
+
+// resolvePlugins registers external plugins for the function's plugin
+// names which are not compiled in, erroring when no executable is found.
+// Default plugins are always compiled in, so are skipped when missing.
+func (f *Function) resolvePlugins() error {
+	externals.Lock()
+	defer externals.Unlock()
+
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+
+	for _, name := range f.Plugins {
+		if _, ok := plugins[name]; ok {
+			continue
+		}
+
+		command, ok := externals.commands[name]
+		if !ok && contains(defaultPlugins, name) {
+			continue
+		}
+
+		if !ok {
+			path, err := exec.LookPath(ExternalPrefix + name)
+			if err != nil {
+				return errors.Errorf("plugin %q is not built in and %s%s is not in PATH", name, ExternalPrefix, name)
+			}
+			command = path
+		}
+
+		f.Log.WithField("command", command).Debugf("using external plugin %s", name)
+		plugins[name] = &External{Name: name, Command: command}
+	}
+
+	return nil
+}
+
+// Open implementation.
+func (e *External) Open(fn *Function) error {
+	return e.config(hookOpen, fn)
+}
+
+// Build implementation.
+func (e *External) Build(fn *Function, zip *archive.Zip) error {
+	res, err := e.hook(hookBuild, fn)
+	if err != nil || res == nil {
+		return err
+	}
+
+	for _, file := range res.Files {
+		fn.Log.Debugf("plugin %s adding %s", e.Name, file.Path)
+		if err := zip.AddBytes(file.Path, file.Content); err != nil {
+			return errors.Wrapf(err, "plugin %s: adding %s", e.Name, file.Path)
+		}
+	}
+
+	return nil
+}
+
+// Clean implementation.
+func (e *External) Clean(fn *Function) error {
+	_, err := e.hook(hookClean, fn)
+	return err
+}
+
+// Deploy implementation.
+func (e *External) Deploy(fn *Function) error {
+	return e.config(hookDeploy, fn)
+}
+
+// config requests `hook`, replacing the function's config when responded.
+func (e *External) config(hook string, fn *Function) error {
+	res, err := e.hook(hook, fn)
+	if err != nil || res == nil {
+		return err
+	}
+
+	if res.Config != nil {
+		fn.Config = *res.Config
+	}
+
+	return nil
+}
+
+// hook requests `hook` for `fn`, returning nil when the plugin doesn't react to it.
+func (e *External) hook(hook string, fn *Function) (*ExternalResponse, error) {
+	e.once.Do(func() {
+		res, err := e.request(&ExternalRequest{Hook: hookDescribe})
+		if err != nil {
+			e.err = err
+			return
+		}
+
+		e.hooks = make(map[string]bool)
+		for _, name := range res.Hooks {
+			e.hooks[name] = true
+		}
+	})
+
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	if !e.hooks[hook] {
+		return nil, nil
+	}
+
+	return e.request(&ExternalRequest{
+		Hook: hook,
+		Function: &ExternalFunction{
+			Name:         fn.Name,
+			FunctionName: fn.FunctionName,
+			Path:         fn.Path,
+			Config:       fn.Config,
+		},
+	})
+}
+
+// request runs the plugin with `req` on stdin, returning its response.
+func (e *External) request(req *ExternalRequest) (*ExternalResponse, error) {
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+
+	cmd := exec.Command(e.Command)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+
+	if req.Function != nil {
+		cmd.Dir = req.Function.Path
+	}
+
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "plugin %s: %s", e.Name, req.Hook)
+	}
+
+	var res ExternalResponse
+	if out.Len() == 0 {
+		return &res, nil
+	}
+
+	if err := json.Unmarshal(out.Bytes(), &res); err != nil {
+		return nil, errors.Wrapf(err, "plugin %s: %s: decoding response", e.Name, req.Hook)
+	}
+
+	if res.Error != "" {
+		return nil, errors.Errorf("plugin %s: %s: %s", e.Name, req.Hook, res.Error)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,60 @@
+package function_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+)
+
+func TestFunction_external(t *testing.T) {
+	command, err := filepath.Abs("_fixtures/plugin/apex-plugin-test")
+	assert.NoError(t, err)
+	function.RegisterExternalPlugin("test", command)
+
+	src, err := ioutil.TempDir("", "apex-src")
+	assert.NoError(t, err)
+	defer os.RemoveAll(src)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "index.js"), []byte("exports.handle = () => {}"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "function.json"), []byte(`{"runtime": "nodejs", "memory": 128, "timeout": 3, "role": "role", "handler": "index.handle"}`), 0644))
+
+	fn := &function.Function{
+		Path:    src,
+		Log:     log.Log,
+		Plugins: []string{"test"},
+	}
+
+	assert.NoError(t, fn.Open(""))
+
+	b, err := fn.BuildBytes()
+	assert.NoError(t, err)
+
+	r, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	assert.NoError(t, err)
+
+	files := make(map[string]bool)
+	for _, f := range r.File {
+		files[f.Name] = true
+	}
+	assert.True(t, files["plugin.txt"], "file added by plugin")
+
+	assert.EqualError(t, fn.Clean(), "plugin test: clean: cleaning failed")
+}
+
+func TestFunction_external_missing(t *testing.T) {
+	fn := &function.Function{
+		Path:    "_fixtures/nodejsLocal",
+		Log:     log.Log,
+		Plugins: []string{"missing"},
+	}
+
+	assert.EqualError(t, fn.Open(""), `resolving plugins: plugin "missing" is not built in and apex-plugin-missing is not in PATH`)
+}
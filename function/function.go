@@ -17,8 +17,12 @@ import (
 	"github.com/apex/log"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 	"gopkg.in/validator.v2"
@@ -76,7 +80,7 @@ type InvokeError struct {
 	Message string   `json:"errorMessage"`
 	Type    string   `json:"errorType"`
 	Stack   []string `json:"stackTrace"`
-	Handled bool
+	Handled bool     `json:"handled"`
 }
 
 // Error message.
@@ -100,26 +104,54 @@ type Config struct {
 	KMSKeyArn        string            `json:"kms_arn"`
 	DeadLetterARN    string            `json:"deadletter_arn"`
 	Region           string            `json:"region"`
+	Regions          []string          `json:"regions"`
 	Edge             bool              `json:"edge"`
 	Zip              string            `json:"zip"`
+	S3Bucket         string            `json:"s3Bucket"`
+	S3Prefix         string            `json:"s3Prefix"`
+	Layers           []string          `json:"layers"`
+	Architecture     string            `json:"architecture" validate:"regexp=^(x86_64|arm64)?$"`
+	Triggers         *Triggers         `json:"triggers"`
+	DependsOn        []string          `json:"dependsOn"`
+
+	ReservedConcurrency    *int64 `json:"reservedConcurrency"`
+	ProvisionedConcurrency *int64 `json:"provisionedConcurrency"`
 }
 
 // Function represents a Lambda function, with configuration loaded
 // from the "function.json" file on disk.
 type Function struct {
 	Config
-	Name         string
-	FunctionName string
-	Path         string
-	Service      lambdaiface.LambdaAPI
-	Log          log.Interface
-	IgnoreFile   []byte
-	Plugins      []string
-	Alias        string
+	Name           string
+	FunctionName   string
+	Path           string
+	Service        lambdaiface.LambdaAPI
+	S3             s3iface.S3API
+	Events         cloudwatcheventsiface.CloudWatchEventsAPI
+	SSM            ssmiface.SSMAPI
+	SecretsManager secretsmanageriface.SecretsManagerAPI
+	Log            log.Interface
+	IgnoreFile     []byte
+	Plugins        []string
+	Alias          string
+	Canary         *Canary
+	Release        *Release
+	BuildCache     string
+	DryRun         bool
+	env            string
+	deployment     *HookContext
+	functionARN    string
+
+	// triggersManaged is true when the triggers of the alias were deployed before.
+	triggersManaged bool
+
+	// reserved is true when the deployed function has reserved concurrency.
+	reserved bool
 }
 
 // Open the function.json file and prime the config.
 func (f *Function) Open(environment string) error {
+	f.env = environment
 	f.defaults()
 
 	f.Log = f.Log.WithFields(log.Fields{
@@ -133,6 +165,10 @@ func (f *Function) Open(environment string) error {
 		return errors.Wrap(err, "loading config")
 	}
 
+	if err := f.resolvePlugins(); err != nil {
+		return errors.Wrap(err, "resolving plugins")
+	}
+
 	if err := f.hookOpen(); err != nil {
 		return errors.Wrap(err, "open hook")
 	}
@@ -178,50 +214,39 @@ func (f *Function) defaults() {
 	f.Setenv("LAMBDA_FUNCTION_NAME", f.FunctionName)
 }
 
-// loadConfig for `environment`, attempt function.ENV.js first, then
-// fall back on function.json if it is available.
+// loadConfig for `environment`, deep-merging function.json and the
+// function.ENV.json overlay, when available, onto the inherited config.
+// Null values delete inherited keys, such as project environment variables.
 func (f *Function) loadConfig(environment string) error {
-	path := fmt.Sprintf("function.%s.json", environment)
-
-	ok, err := f.tryConfig(path)
-	if err != nil {
-		return err
-	}
+	paths := []string{filepath.Join(f.Path, "function.json")}
 
-	if ok {
-		f.Log.WithField("config", path).Debug("loaded config")
-		return nil
+	if environment != "" {
+		paths = append(paths, filepath.Join(f.Path, fmt.Sprintf("function.%s.json", environment)))
 	}
 
-	ok, err = f.tryConfig("function.json")
+	loaded, err := utils.LoadConfig(&f.Config, paths...)
 	if err != nil {
 		return err
 	}
 
-	if ok {
-		f.Log.WithField("config", "function.json").Debug("loaded config")
-		return nil
+	for _, path := range loaded {
+		f.Log.WithField("config", filepath.Base(path)).Debug("loaded config")
 	}
 
-	return nil
-}
-
-// tryConfig
-func (f *Function) tryConfig(path string) (bool, error) {
-	file, err := os.Open(filepath.Join(f.Path, path))
-	if os.IsNotExist(err) {
-		return false, nil
+	// keys deleted by overlays
+	if f.Environment == nil {
+		f.Environment = make(map[string]string)
 	}
 
-	if err != nil {
-		return false, err
+	if f.VPC.Subnets == nil {
+		f.VPC.Subnets = []string{}
 	}
 
-	if err := json.NewDecoder(file).Decode(&f.Config); err != nil {
-		return false, err
+	if f.VPC.SecurityGroups == nil {
+		f.VPC.SecurityGroups = []string{}
 	}
 
-	return true, nil
+	return nil
 }
 
 // Setenv sets environment variable `name` to `value`.
@@ -231,19 +256,78 @@ func (f *Function) Setenv(name, value string) {
 
 // Deploy generates a zip and creates or deploy the function.
 // If the configuration hasn't been changed it will deploy only code,
-// otherwise it will deploy both configuration and code.
+// otherwise it will deploy both configuration and code. Triggers
+// are reconciled once the alias is in place.
 func (f *Function) Deploy() error {
-	f.Log.Debug("deploying")
+	zip, err := f.Package()
+	if err != nil {
+		return err
+	}
 
+	return f.DeployZip(zip)
+}
+
+// Package generates the zip to deploy and runs the deploy hook.
+func (f *Function) Package() ([]byte, error) {
 	zip, err := f.ZipBytes()
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if problems := f.ValidateZip(zip); len(problems) > 0 {
+		return nil, problems
 	}
 
 	if err := f.hookDeploy(); err != nil {
+		return nil, err
+	}
+
+	return zip, nil
+}
+
+// DeployZip creates or deploys the function with the packaged `zip`,
+// which is shared by the regions a function is deployed to. The postdeploy
+// hooks are run once deployed, and the onfailure hooks when it fails.
+func (f *Function) DeployZip(zip []byte) error {
+	f.Log.Debug("deploying")
+
+	f.deployment = f.HookContext()
+	f.previousVersion()
+
+	if err := f.deployZip(zip); err != nil {
+		f.deployment.Error = err
+		if err := f.hookFailure(f.deployment); err != nil {
+			f.Log.WithError(err).Warn("onfailure hook")
+		}
 		return err
 	}
 
+	return f.hookPostDeploy(f.deployment)
+}
+
+// deployZip deploys the function, its provisioned concurrency and triggers.
+func (f *Function) deployZip(zip []byte) error {
+	if err := f.ResolveSecrets(); err != nil {
+		return err
+	}
+
+	if problems := f.ValidateEnvironment(); len(problems) > 0 {
+		return problems
+	}
+
+	if err := f.deploy(zip); err != nil {
+		return err
+	}
+
+	if err := f.DeployProvisionedConcurrency(); err != nil {
+		return errors.Wrap(err, "provisioned concurrency")
+	}
+
+	return f.DeployTriggers()
+}
+
+// deploy creates the function, or deploys its configuration and code.
+func (f *Function) deploy(zip []byte) error {
 	config, err := f.GetConfig()
 
 	if e, ok := err.(awserr.Error); ok {
@@ -256,6 +340,9 @@ func (f *Function) Deploy() error {
 		return err
 	}
 
+	f.triggersManaged = config.Tags[triggersTag+f.Alias] != nil
+	f.reserved = config.Concurrency != nil && config.Concurrency.ReservedConcurrentExecutions != nil
+
 	if f.configChanged(config) {
 		f.Log.Debug("config changed")
 		return f.DeployConfigAndCode(zip)
@@ -278,7 +365,7 @@ func (f *Function) DeployCode(zip []byte, config *lambda.GetFunctionOutput) erro
 		// Creating an alias to $LATEST would mean its tied to any future deploys.
 		// To correct this behaviour, we take the latest version at the time of deploy.
 		if *version == "$LATEST" {
-			versions, err := f.versions()
+			versions, err := f.Versions()
 			if err != nil {
 				return err
 			}
@@ -286,6 +373,7 @@ func (f *Function) DeployCode(zip []byte, config *lambda.GetFunctionOutput) erro
 			version = versions[len(versions)-1].Version
 		}
 
+		f.deployed(*version, config.Configuration.FunctionArn)
 		return f.CreateOrUpdateAlias(f.Alias, *version)
 	}
 
@@ -311,6 +399,7 @@ func (f *Function) DeployConfigAndCode(zip []byte) error {
 		Handler:      &f.Handler,
 		KMSKeyArn:    &f.KMSKeyArn,
 		Environment:  f.environment(),
+		Layers:       aws.StringSlice(f.layers()),
 		VpcConfig: &lambda.VpcConfig{
 			SecurityGroupIds: aws.StringSlice(f.VPC.SecurityGroups),
 			SubnetIds:        aws.StringSlice(f.VPC.Subnets),
@@ -328,6 +417,19 @@ func (f *Function) DeployConfigAndCode(zip []byte) error {
 		return err
 	}
 
+	// the code can't be updated until the configuration update completes
+	err = f.Service.WaitUntilFunctionUpdated(&lambda.GetFunctionConfigurationInput{
+		FunctionName: &f.FunctionName,
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "waiting for config update")
+	}
+
+	if err := f.putReservedConcurrency(); err != nil {
+		return errors.Wrap(err, "reserved concurrency")
+	}
+
 	return f.Update(zip)
 }
 
@@ -373,22 +475,50 @@ func (f *Function) GetConfigCurrent() (*lambda.GetFunctionOutput, error) {
 func (f *Function) Update(zip []byte) error {
 	f.Log.Info("updating function")
 
+	code, err := f.code(zip)
+	if err != nil {
+		return err
+	}
+
 	updated, err := f.Service.UpdateFunctionCode(&lambda.UpdateFunctionCodeInput{
+		FunctionName:  &f.FunctionName,
+		ZipFile:       code.ZipFile,
+		S3Bucket:      code.S3Bucket,
+		S3Key:         code.S3Key,
+		Architectures: aws.StringSlice([]string{f.architecture()}),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	err = f.Service.WaitUntilFunctionUpdated(&lambda.GetFunctionConfigurationInput{
 		FunctionName: &f.FunctionName,
-		Publish:      aws.Bool(true),
-		ZipFile:      zip,
 	})
 
 	if err != nil {
 		return err
 	}
 
-	if err := f.CreateOrUpdateAlias(f.Alias, *updated.Version); err != nil {
+	version, err := f.publish(updated.CodeSha256)
+	if err != nil {
+		return err
+	}
+
+	f.deployed(version, updated.FunctionArn)
+
+	if f.Canary != nil {
+		err = f.DeployCanary(version)
+	} else {
+		err = f.CreateOrUpdateAlias(f.Alias, version)
+	}
+
+	if err != nil {
 		return err
 	}
 
 	f.Log.WithFields(log.Fields{
-		"version": *updated.Version,
+		"version": version,
 		"name":    f.FunctionName,
 	}).Info("function updated")
 
@@ -399,20 +529,23 @@ func (f *Function) Update(zip []byte) error {
 func (f *Function) Create(zip []byte) error {
 	f.Log.Info("creating function")
 
+	code, err := f.code(zip)
+	if err != nil {
+		return err
+	}
+
 	params := &lambda.CreateFunctionInput{
-		FunctionName: &f.FunctionName,
-		Description:  &f.Description,
-		MemorySize:   &f.Memory,
-		Timeout:      &f.Timeout,
-		Runtime:      &f.Runtime,
-		Handler:      &f.Handler,
-		Role:         &f.Role,
-		KMSKeyArn:    &f.KMSKeyArn,
-		Publish:      aws.Bool(true),
-		Environment:  f.environment(),
-		Code: &lambda.FunctionCode{
-			ZipFile: zip,
-		},
+		FunctionName:  &f.FunctionName,
+		Description:   &f.Description,
+		MemorySize:    &f.Memory,
+		Timeout:       &f.Timeout,
+		Runtime:       &f.Runtime,
+		Handler:       &f.Handler,
+		Role:          &f.Role,
+		KMSKeyArn:     &f.KMSKeyArn,
+		Environment:   f.environment(),
+		Code:          code,
+		Architectures: aws.StringSlice([]string{f.architecture()}),
 		VpcConfig: &lambda.VpcConfig{
 			SecurityGroupIds: aws.StringSlice(f.VPC.SecurityGroups),
 			SubnetIds:        aws.StringSlice(f.VPC.Subnets),
@@ -425,17 +558,40 @@ func (f *Function) Create(zip []byte) error {
 		}
 	}
 
+	if len(f.Layers) > 0 {
+		params.Layers = aws.StringSlice(f.Layers)
+	}
+
 	created, err := f.Service.CreateFunction(params)
 	if err != nil {
 		return err
 	}
 
-	if err := f.CreateOrUpdateAlias(f.Alias, *created.Version); err != nil {
+	if err := f.putReservedConcurrency(); err != nil {
+		return errors.Wrap(err, "reserved concurrency")
+	}
+
+	err = f.Service.WaitUntilFunctionActive(&lambda.GetFunctionConfigurationInput{
+		FunctionName: &f.FunctionName,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	version, err := f.publish(created.CodeSha256)
+	if err != nil {
+		return err
+	}
+
+	f.deployed(version, created.FunctionArn)
+
+	if err := f.CreateOrUpdateAlias(f.Alias, version); err != nil {
 		return err
 	}
 
 	f.Log.WithFields(log.Fields{
-		"version": *created.Version,
+		"version": version,
 		"name":    f.FunctionName,
 	}).Info("function created")
 
@@ -535,7 +691,7 @@ func (f *Function) Rollback() error {
 
 	f.Log.Debugf("current version: %s", *alias.FunctionVersion)
 
-	versions, err := f.versions()
+	versions, err := f.Versions()
 	if err != nil {
 		return err
 	}
@@ -566,7 +722,7 @@ func (f *Function) Rollback() error {
 
 	f.Log.WithField("current version", rollback).Info("function rolled back")
 
-	return nil
+	return f.hookRollback(f.rolledBack(alias, rollback))
 }
 
 // RollbackVersion the function to the specified version.
@@ -598,7 +754,7 @@ func (f *Function) RollbackVersion(version string) error {
 
 	f.Log.WithField("current version", version).Info("function rolled back")
 
-	return nil
+	return f.hookRollback(f.rolledBack(alias, version))
 }
 
 // ZipBytes builds the in-memory zip, or reads
@@ -629,13 +785,49 @@ func (f *Function) BuildBytes() ([]byte, error) {
 	return b, nil
 }
 
-// Build returns the zipped contents of the function.
+// Build returns the zipped contents of the function. When a BuildCache
+// directory is set, builds of unchanged functions are read from the cache
+// without running hooks.
 func (f *Function) Build() (io.Reader, error) {
+	if f.BuildCache == "" {
+		return f.build()
+	}
+
+	key, err := f.cacheKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "computing cache key")
+	}
+
+	if b := f.cached(key); b != nil {
+		f.Log.WithField("key", key[:12]).Info("build unchanged, using cache")
+		return bytes.NewReader(b), nil
+	}
+
+	buf, err := f.build()
+	if err != nil {
+		return nil, err
+	}
+
+	b := buf.Bytes()
+
+	if err := f.cache(key, b); err != nil {
+		f.Log.WithError(err).Warn("caching build")
+	}
+
+	return bytes.NewReader(b), nil
+}
+
+// build creates the zipped contents of the function.
+func (f *Function) build() (*bytes.Buffer, error) {
 	f.Log.Debugf("creating build")
 
 	buf := new(bytes.Buffer)
 	zip := archive.NewZip(buf)
 
+	if err := f.hookPreBuild(); err != nil {
+		return nil, err
+	}
+
 	if err := f.hookBuild(zip); err != nil {
 		return nil, err
 	}
@@ -645,46 +837,20 @@ func (f *Function) Build() (io.Reader, error) {
 		return nil, err
 	}
 
-	for _, path := range paths {
-		f.Log.WithField("file", path).Debug("add file to zip")
-
-		fullPath := filepath.Join(f.Path, path)
-
-		fh, err := os.Open(fullPath)
-		if err != nil {
-			return nil, err
-		}
+	f.Log.Debugf("add %d files to zip", len(paths))
 
-		info, err := fh.Stat()
-		if err != nil {
-			return nil, err
-		}
-
-		if info.IsDir() {
-			// It's a symlink, otherwise it shouldn't be returned by LoadFiles
-			linkPath, err := filepath.EvalSymlinks(fullPath)
-			if err != nil {
-				return nil, err
-			}
-
-			if err := zip.AddDir(linkPath, path); err != nil {
-				return nil, err
-			}
-		} else {
-			if err := zip.AddFile(path, fh); err != nil {
-				return nil, err
-			}
-		}
-
-		if err := fh.Close(); err != nil {
-			return nil, err
-		}
+	if err := zip.AddFiles(f.Path, paths); err != nil {
+		return nil, err
 	}
 
 	if err := zip.Close(); err != nil {
 		return nil, err
 	}
 
+	if err := f.hookPostBuild(); err != nil {
+		return nil, err
+	}
+
 	return buf, nil
 }
 
@@ -725,8 +891,8 @@ func (f *Function) cleanup() error {
 	return f.removeVersions(versionsToCleanup)
 }
 
-// versions returns list of all versions deployed to AWS Lambda
-func (f *Function) versions() ([]*lambda.FunctionConfiguration, error) {
+// Versions returns list of all versions deployed to AWS Lambda, excluding $LATEST
+func (f *Function) Versions() ([]*lambda.FunctionConfiguration, error) {
 	var list []*lambda.FunctionConfiguration
 	request := lambda.ListVersionsByFunctionInput{
 		FunctionName: &f.FunctionName,
@@ -754,7 +920,7 @@ func (f *Function) versions() ([]*lambda.FunctionConfiguration, error) {
 
 // versionsToCleanup returns list of versions to remove after updating function
 func (f *Function) versionsToCleanup() ([]*lambda.FunctionConfiguration, error) {
-	versions, err := f.versions()
+	versions, err := f.Versions()
 	if err != nil {
 		return nil, err
 	}
@@ -796,30 +962,37 @@ func (f *Function) currentVersionAlias() (*lambda.AliasConfiguration, error) {
 	})
 }
 
-// configChanged checks if function configuration differs from configuration stored in AWS Lambda
-func (f *Function) configChanged(config *lambda.GetFunctionOutput) bool {
-	type diffConfig struct {
-		Description      string
-		Memory           int64
-		Timeout          int64
-		Role             string
-		Runtime          string
-		Handler          string
-		VPC              vpc.VPC
-		Environment      []string
-		KMSKeyArn        string
-		DeadLetterConfig lambda.DeadLetterConfig
-	}
+// diffConfig is the configuration compared against the configuration stored in AWS Lambda.
+type diffConfig struct {
+	Description         string
+	Memory              int64
+	Timeout             int64
+	Role                string
+	Runtime             string
+	Handler             string
+	VPC                 vpc.VPC
+	Environment         []string
+	KMSKeyArn           string
+	DeadLetterConfig    lambda.DeadLetterConfig
+	Layers              []string
+	Architecture        string
+	ReservedConcurrency *int64
+}
 
+// localConfig returns the local configuration for comparison.
+func (f *Function) localConfig() *diffConfig {
 	localConfig := &diffConfig{
-		Description: f.Description,
-		Memory:      f.Memory,
-		Timeout:     f.Timeout,
-		Role:        f.Role,
-		Runtime:     f.Runtime,
-		Handler:     f.Handler,
-		KMSKeyArn:   f.KMSKeyArn,
-		Environment: environ(f.environment().Variables),
+		Description:         f.Description,
+		Memory:              f.Memory,
+		Timeout:             f.Timeout,
+		Role:                f.Role,
+		Runtime:             f.Runtime,
+		Handler:             f.Handler,
+		KMSKeyArn:           f.KMSKeyArn,
+		Environment:         environ(f.environment().Variables),
+		Layers:              f.layers(),
+		Architecture:        f.architecture(),
+		ReservedConcurrency: f.reservedConcurrency(),
 		VPC: vpc.VPC{
 			Subnets:        f.VPC.Subnets,
 			SecurityGroups: f.VPC.SecurityGroups,
@@ -832,53 +1005,76 @@ func (f *Function) configChanged(config *lambda.GetFunctionOutput) bool {
 		}
 	}
 
+	// don't make any assumptions about the order AWS stores the subnets or security groups
+	sort.StringSlice(localConfig.VPC.Subnets).Sort()
+	sort.StringSlice(localConfig.VPC.SecurityGroups).Sort()
+
+	return localConfig
+}
+
+// remoteConfig returns the configuration stored in AWS Lambda for comparison.
+func (f *Function) remoteConfig(output *lambda.GetFunctionOutput) *diffConfig {
+	config := output.Configuration
 	remoteConfig := &diffConfig{
-		Description: *config.Configuration.Description,
-		Memory:      *config.Configuration.MemorySize,
-		Timeout:     *config.Configuration.Timeout,
-		Role:        *config.Configuration.Role,
-		Runtime:     *config.Configuration.Runtime,
-		Handler:     *config.Configuration.Handler,
+		Description: *config.Description,
+		Memory:      *config.MemorySize,
+		Timeout:     *config.Timeout,
+		Role:        *config.Role,
+		Runtime:     *config.Runtime,
+		Handler:     *config.Handler,
+	}
+
+	if config.KMSKeyArn != nil {
+		remoteConfig.KMSKeyArn = *config.KMSKeyArn
+	}
+
+	if config.Environment != nil {
+		remoteConfig.Environment = environ(config.Environment.Variables)
 	}
 
-	if config.Configuration.KMSKeyArn != nil {
-		remoteConfig.KMSKeyArn = *config.Configuration.KMSKeyArn
+	for _, l := range config.Layers {
+		remoteConfig.Layers = append(remoteConfig.Layers, *l.Arn)
 	}
 
-	if config.Configuration.Environment != nil {
-		remoteConfig.Environment = environ(config.Configuration.Environment.Variables)
+	remoteConfig.Architecture = RemoteArchitecture(config)
+
+	if output.Concurrency != nil {
+		remoteConfig.ReservedConcurrency = output.Concurrency.ReservedConcurrentExecutions
 	}
 
-	if config.Configuration.DeadLetterConfig != nil {
+	if config.DeadLetterConfig != nil {
 		remoteConfig.DeadLetterConfig = lambda.DeadLetterConfig{
-			TargetArn: config.Configuration.DeadLetterConfig.TargetArn,
+			TargetArn: config.DeadLetterConfig.TargetArn,
 		}
 	}
 
 	// SDK is inconsistent here. VpcConfig can be nil or empty struct.
 	remoteConfig.VPC = vpc.VPC{Subnets: []string{}, SecurityGroups: []string{}}
-	if config.Configuration.VpcConfig != nil {
+	if config.VpcConfig != nil {
 		remoteConfig.VPC = vpc.VPC{
-			Subnets:        aws.StringValueSlice(config.Configuration.VpcConfig.SubnetIds),
-			SecurityGroups: aws.StringValueSlice(config.Configuration.VpcConfig.SecurityGroupIds),
+			Subnets:        aws.StringValueSlice(config.VpcConfig.SubnetIds),
+			SecurityGroups: aws.StringValueSlice(config.VpcConfig.SecurityGroupIds),
 		}
 	}
 
 	// don't make any assumptions about the order AWS stores the subnets or security groups
 	sort.StringSlice(remoteConfig.VPC.Subnets).Sort()
-	sort.StringSlice(localConfig.VPC.Subnets).Sort()
 	sort.StringSlice(remoteConfig.VPC.SecurityGroups).Sort()
-	sort.StringSlice(localConfig.VPC.SecurityGroups).Sort()
 
-	localConfigJSON, _ := json.Marshal(localConfig)
-	remoteConfigJSON, _ := json.Marshal(remoteConfig)
+	return remoteConfig
+}
+
+// configChanged checks if function configuration differs from configuration stored in AWS Lambda
+func (f *Function) configChanged(config *lambda.GetFunctionOutput) bool {
+	localConfigJSON, _ := json.Marshal(f.localConfig())
+	remoteConfigJSON, _ := json.Marshal(f.remoteConfig(config))
 	return string(localConfigJSON) != string(remoteConfigJSON)
 }
 
 // hookOpen calls Openers.
 func (f *Function) hookOpen() error {
 	for _, name := range f.Plugins {
-		if p, ok := plugins[name].(Opener); ok {
+		if p, ok := plugin(name).(Opener); ok {
 			if err := p.Open(f); err != nil {
 				return err
 			}
@@ -890,7 +1086,7 @@ func (f *Function) hookOpen() error {
 // hookBuild calls Builders.
 func (f *Function) hookBuild(zip *archive.Zip) error {
 	for _, name := range f.Plugins {
-		if p, ok := plugins[name].(Builder); ok {
+		if p, ok := plugin(name).(Builder); ok {
 			if err := p.Build(f, zip); err != nil {
 				return err
 			}
@@ -902,7 +1098,7 @@ func (f *Function) hookBuild(zip *archive.Zip) error {
 // hookClean calls Cleaners.
 func (f *Function) hookClean() error {
 	for _, name := range f.Plugins {
-		if p, ok := plugins[name].(Cleaner); ok {
+		if p, ok := plugin(name).(Cleaner); ok {
 			if err := p.Clean(f); err != nil {
 				return err
 			}
@@ -914,7 +1110,7 @@ func (f *Function) hookClean() error {
 // hookDeploy calls Deployers.
 func (f *Function) hookDeploy() error {
 	for _, name := range f.Plugins {
-		if p, ok := plugins[name].(Deployer); ok {
+		if p, ok := plugin(name).(Deployer); ok {
 			if err := p.Deploy(f); err != nil {
 				return err
 			}
@@ -934,6 +1130,34 @@ func (f *Function) environment() *lambda.Environment {
 	return &lambda.Environment{Variables: env}
 }
 
+// layers returns the layer ARNs, or nil when there are none.
+func (f *Function) layers() []string {
+	if len(f.Layers) == 0 {
+		return nil
+	}
+
+	return f.Layers
+}
+
+// architecture returns the instruction set architecture, defaulting to x86_64.
+func (f *Function) architecture() string {
+	if f.Architecture == "" {
+		return lambda.ArchitectureX8664
+	}
+
+	return f.Architecture
+}
+
+// RemoteArchitecture returns the architecture of the deployed `config`,
+// functions created before architectures were supported report none.
+func RemoteArchitecture(config *lambda.FunctionConfiguration) string {
+	if len(config.Architectures) == 0 {
+		return lambda.ArchitectureX8664
+	}
+
+	return *config.Architectures[0]
+}
+
 // environment sorted and joined.
 func environ(env map[string]*string) []string {
 	var keys []string
@@ -58,6 +58,19 @@ func TestFunction_Open_requireConfigValues(t *testing.T) {
 	roleErr := fn.Open("")
 	assert.Contains(t, roleErr.Error(), "Role: zero value")
 
+	fn = &function.Function{
+		Config: function.Config{
+			Memory:       128,
+			Timeout:      3,
+			Role:         "iamrole",
+			Architecture: "sparc",
+		},
+		Path: "_fixtures/nodejsDefaultFile",
+		Log:  log.Log,
+	}
+	architectureErr := fn.Open("")
+	assert.Contains(t, architectureErr.Error(), "Architecture: regular expression mismatch")
+
 }
 
 func TestFunction_Open_detectRuntime(t *testing.T) {
@@ -93,6 +106,7 @@ func TestFunction_Create_edgeFunction(t *testing.T) {
 	retainedVersions := 1
 
 	serviceMock.EXPECT().CreateFunction(&lambda.CreateFunctionInput{
+		Architectures: aws.StringSlice([]string{"x86_64"}),
 		Code: &lambda.FunctionCode{
 			ZipFile: zip,
 		},
@@ -101,7 +115,6 @@ func TestFunction_Create_edgeFunction(t *testing.T) {
 		Environment: &lambda.Environment{
 			Variables: make(map[string]*string),
 		}, // Edge does not support environment
-		Publish:    aws.Bool(true),
 		Handler:    &handler,
 		KMSKeyArn:  &kmskeyarn,
 		MemorySize: &memorysize,
@@ -112,6 +125,14 @@ func TestFunction_Create_edgeFunction(t *testing.T) {
 			SecurityGroupIds: []*string{},
 			SubnetIds:        []*string{},
 		},
+	}).Return(&lambda.FunctionConfiguration{
+		Version: aws.String("$LATEST"),
+	}, nil)
+	serviceMock.EXPECT().WaitUntilFunctionActive(&lambda.GetFunctionConfigurationInput{
+		FunctionName: &fnName,
+	}).Return(nil)
+	serviceMock.EXPECT().PublishVersion(&lambda.PublishVersionInput{
+		FunctionName: &fnName,
 	}).Return(&lambda.FunctionConfiguration{
 		Version: &updatedVersion,
 	}, nil)
@@ -198,9 +219,19 @@ func TestFunction_DeployCode_Success(t *testing.T) {
 	retainedVersions := 1
 
 	serviceMock.EXPECT().UpdateFunctionCode(&lambda.UpdateFunctionCodeInput{
+		FunctionName:  &fnName,
+		ZipFile:       code,
+		Architectures: aws.StringSlice([]string{"x86_64"}),
+	}).Return(&lambda.FunctionConfiguration{
+		Version:    aws.String("$LATEST"),
+		CodeSha256: &codeSha256,
+	}, nil)
+	serviceMock.EXPECT().WaitUntilFunctionUpdated(&lambda.GetFunctionConfigurationInput{
+		FunctionName: &fnName,
+	}).Return(nil)
+	serviceMock.EXPECT().PublishVersion(&lambda.PublishVersionInput{
 		FunctionName: &fnName,
-		Publish:      aws.Bool(true),
-		ZipFile:      code,
+		CodeSha256:   &codeSha256,
 	}).Return(&lambda.FunctionConfiguration{
 		Version: &updatedVersion,
 	}, nil)
@@ -233,6 +264,48 @@ func TestFunction_DeployCode_Success(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestFunction_DeployConfigAndCode_waitsForConfig(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+	code := []byte("something")
+	codeSha256 := utils.Sha256(code)
+	retainedVersions := 1
+
+	// the code is only updated once the configuration update completes
+	gomock.InOrder(
+		serviceMock.EXPECT().UpdateFunctionConfiguration(gomock.Any()).Return(&lambda.FunctionConfiguration{}, nil),
+		serviceMock.EXPECT().WaitUntilFunctionUpdated(&lambda.GetFunctionConfigurationInput{
+			FunctionName: aws.String("testfn"),
+		}).Return(nil),
+		serviceMock.EXPECT().UpdateFunctionCode(gomock.Any()).Return(&lambda.FunctionConfiguration{
+			Version:    aws.String("$LATEST"),
+			CodeSha256: &codeSha256,
+		}, nil),
+		serviceMock.EXPECT().WaitUntilFunctionUpdated(gomock.Any()).Return(nil),
+		serviceMock.EXPECT().PublishVersion(gomock.Any()).Return(&lambda.FunctionConfiguration{
+			Version: aws.String("2"),
+		}, nil),
+		serviceMock.EXPECT().CreateAlias(gomock.Any()).Return(&lambda.AliasConfiguration{}, nil),
+		serviceMock.EXPECT().ListVersionsByFunction(gomock.Any()).Return(&lambda.ListVersionsByFunctionOutput{
+			Versions: []*lambda.FunctionConfiguration{{}},
+		}, nil),
+	)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Alias:        "current",
+		Config: function.Config{
+			RetainedVersions: &retainedVersions,
+			Environment:      make(map[string]string),
+		},
+	}
+
+	assert.NoError(t, fn.DeployConfigAndCode(code))
+}
+
 func TestFunction_DeployCode_UnchangedUpdatesAlias(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -0,0 +1,208 @@
+package function
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+// HookContext describes the function version which hooks react to.
+type HookContext struct {
+	Name            string
+	Version         string
+	PreviousVersion string
+	ARN             string
+	Alias           string
+	Environment     string
+	Error           error
+}
+
+// Env returns the context as environment variables, omitting empty values.
+func (c *HookContext) Env() []string {
+	vars := []struct {
+		name  string
+		value string
+	}{
+		{"APEX_FUNCTION_NAME", c.Name},
+		{"APEX_FUNCTION_VERSION", c.Version},
+		{"APEX_FUNCTION_PREVIOUS_VERSION", c.PreviousVersion},
+		{"APEX_FUNCTION_ARN", c.ARN},
+		{"APEX_ALIAS", c.Alias},
+		{"APEX_ENVIRONMENT", c.Environment},
+	}
+
+	var env []string
+	for _, v := range vars {
+		if v.value != "" {
+			env = append(env, v.name+"="+v.value)
+		}
+	}
+
+	if c.Error != nil {
+		env = append(env, "APEX_ERROR="+c.Error.Error())
+	}
+
+	return env
+}
+
+// HookContext returns the context of the function's alias and environment.
+func (f *Function) HookContext() *HookContext {
+	return &HookContext{
+		Name:        f.Name,
+		Alias:       f.Alias,
+		Environment: f.env,
+	}
+}
+
+// deployed records `version` of the function `arn` in the deployment's context.
+func (f *Function) deployed(version string, arn *string) {
+	if f.deployment == nil {
+		return
+	}
+
+	f.deployment.Version = version
+	if arn != nil {
+		f.functionARN = *arn
+		f.deployment.ARN = qualifiedARN(*arn, version)
+	}
+}
+
+// AliasARN returns the ARN qualified by the function's alias, as
+// deployed or otherwise fetched from the deployed function.
+func (f *Function) AliasARN() (string, error) {
+	if f.functionARN != "" {
+		return qualifiedARN(f.functionARN, f.Alias), nil
+	}
+
+	config, err := f.GetConfig()
+	if err != nil {
+		return "", err
+	}
+
+	arn := aws.StringValue(config.Configuration.FunctionArn)
+	if arn == "" {
+		return "", errors.New("function ARN unknown")
+	}
+
+	f.functionARN = arn
+	return qualifiedARN(f.functionARN, f.Alias), nil
+}
+
+// rolledBack returns the context of rolling back the `alias` to `version`.
+func (f *Function) rolledBack(alias *lambda.AliasConfiguration, version string) *HookContext {
+	c := f.HookContext()
+	c.Version = version
+	c.PreviousVersion = aws.StringValue(alias.FunctionVersion)
+
+	if alias.AliasArn != nil {
+		c.ARN = qualifiedARN(*alias.AliasArn, version)
+	}
+
+	return c
+}
+
+// previousVersion records the version of the alias before it is moved, which
+// is only looked up when hooks receiving it are configured, and ignored
+// when the function or alias does not exist yet.
+func (f *Function) previousVersion() {
+	if len(f.Hooks.PostDeploy) == 0 && len(f.Hooks.OnFailure) == 0 {
+		return
+	}
+
+	alias, err := f.currentVersionAlias()
+	if err != nil {
+		f.Log.WithError(err).Debug("fetching previous version")
+		return
+	}
+
+	f.deployment.PreviousVersion = aws.StringValue(alias.FunctionVersion)
+}
+
+// qualifiedARN returns the ARN qualified by `qualifier`, a version or alias,
+// from the function or alias `arn`.
+func qualifiedARN(arn, qualifier string) string {
+	// arn:aws:lambda:REGION:ACCOUNT:function:NAME[:QUALIFIER]
+	parts := strings.Split(arn, ":")
+	if len(parts) > 7 {
+		parts = parts[:7]
+	}
+
+	return strings.Join(parts, ":") + ":" + qualifier
+}
+
+// hookPreBuild calls PreBuilders.
+func (f *Function) hookPreBuild() error {
+	for _, name := range f.Plugins {
+		if p, ok := plugin(name).(PreBuilder); ok {
+			if err := p.PreBuild(f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hookPostBuild calls PostBuilders.
+func (f *Function) hookPostBuild() error {
+	for _, name := range f.Plugins {
+		if p, ok := plugin(name).(PostBuilder); ok {
+			if err := p.PostBuild(f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hookPostDeploy calls PostDeployers, except for dry-runs.
+func (f *Function) hookPostDeploy(c *HookContext) error {
+	if f.DryRun {
+		f.Log.Debug("skipping postdeploy hooks in dry-run")
+		return nil
+	}
+
+	for _, name := range f.Plugins {
+		if p, ok := plugin(name).(PostDeployer); ok {
+			if err := p.PostDeploy(f, c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hookFailure calls FailureHandlers, except for dry-runs.
+func (f *Function) hookFailure(c *HookContext) error {
+	if f.DryRun {
+		f.Log.Debug("skipping onfailure hooks in dry-run")
+		return nil
+	}
+
+	for _, name := range f.Plugins {
+		if p, ok := plugin(name).(FailureHandler); ok {
+			if err := p.OnFailure(f, c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// hookRollback calls Rollbackers, except for dry-runs.
+func (f *Function) hookRollback(c *HookContext) error {
+	if f.DryRun {
+		f.Log.Debug("skipping rollback hooks in dry-run")
+		return nil
+	}
+
+	for _, name := range f.Plugins {
+		if p, ok := plugin(name).(Rollbacker); ok {
+			if err := p.Rollback(f, c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
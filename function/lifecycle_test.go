@@ -0,0 +1,214 @@
+package function_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/hooks"
+	"github.com/apex/apex/mock"
+	hookplugin "github.com/apex/apex/plugins/hooks"
+)
+
+// hookEnv returns the APEX_ variables a hook command wrote to `path`.
+func hookEnv(t *testing.T, path string) string {
+	b, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	return string(b)
+}
+
+func TestHookContext_Env(t *testing.T) {
+	c := &function.HookContext{
+		Name:    "api",
+		Version: "3",
+		Alias:   "current",
+		Error:   errors.New("boom"),
+	}
+
+	assert.Equal(t, []string{
+		"APEX_FUNCTION_NAME=api",
+		"APEX_FUNCTION_VERSION=3",
+		"APEX_ALIAS=current",
+		"APEX_ERROR=boom",
+	}, c.Env())
+}
+
+func TestFunction_RollbackVersion_hook(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	dir, err := ioutil.TempDir("", "apex-hooks")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{
+		FunctionVersion: aws.String("2"),
+		AliasArn:        aws.String("arn:aws:lambda:us-west-2:123456789012:function:testfn:current"),
+	}, nil)
+	serviceMock.EXPECT().UpdateAlias(gomock.Any()).Return(&lambda.AliasConfiguration{}, nil)
+
+	fn := &function.Function{
+		Name:         "testfn",
+		FunctionName: "testfn",
+		Path:         dir,
+		Service:      serviceMock,
+		Log:          log.Log,
+		Alias:        "current",
+		Plugins:      []string{"hooks"},
+		Config: function.Config{
+			Hooks: hooks.Hooks{
+				Rollback: hooks.Command{"env | grep ^APEX_ | sort > rollback.env"},
+			},
+		},
+	}
+
+	assert.NoError(t, fn.RollbackVersion("1"))
+	assert.Equal(t, `APEX_ALIAS=current
+APEX_FUNCTION_ARN=arn:aws:lambda:us-west-2:123456789012:function:testfn:1
+APEX_FUNCTION_NAME=testfn
+APEX_FUNCTION_PREVIOUS_VERSION=2
+APEX_FUNCTION_VERSION=1
+`, hookEnv(t, filepath.Join(dir, "rollback.env")))
+}
+
+func TestFunction_DeployZip_onfailure(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	dir, err := ioutil.TempDir("", "apex-hooks")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{FunctionVersion: aws.String("3")}, nil)
+	serviceMock.EXPECT().GetFunction(gomock.Any()).Return(nil, errors.New("API err"))
+
+	fn := &function.Function{
+		Name:         "testfn",
+		FunctionName: "testfn",
+		Path:         dir,
+		Service:      serviceMock,
+		Log:          log.Log,
+		Alias:        "current",
+		Plugins:      []string{"hooks"},
+		Config: function.Config{
+			Hooks: hooks.Hooks{
+				PostDeploy: hooks.Command{"touch postdeploy.env"},
+				OnFailure:  hooks.Command{"env | grep ^APEX_ | sort > onfailure.env"},
+			},
+		},
+	}
+
+	assert.EqualError(t, fn.DeployZip(nil), "API err")
+	assert.Equal(t, `APEX_ALIAS=current
+APEX_ERROR=API err
+APEX_FUNCTION_NAME=testfn
+APEX_FUNCTION_PREVIOUS_VERSION=3
+`, hookEnv(t, filepath.Join(dir, "onfailure.env")))
+
+	_, err = os.Stat(filepath.Join(dir, "postdeploy.env"))
+	assert.True(t, os.IsNotExist(err), "postdeploy is not run")
+}
+
+func TestFunction_RollbackVersion_hookDryRun(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{FunctionVersion: aws.String("2")}, nil)
+	serviceMock.EXPECT().UpdateAlias(gomock.Any()).Return(&lambda.AliasConfiguration{}, nil)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Alias:        "current",
+		Plugins:      []string{"hooks"},
+		DryRun:       true,
+		Config: function.Config{
+			Hooks: hooks.Hooks{
+				Rollback: hooks.Command{"exit 1"},
+			},
+		},
+	}
+
+	assert.NoError(t, fn.RollbackVersion("1"))
+}
+
+func TestFunction_Clean_hookCommands(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apex-hooks")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fn := &function.Function{
+		Name:    "testfn",
+		Path:    dir,
+		Log:     log.Log,
+		Alias:   "current",
+		Plugins: []string{"hooks"},
+		Config: function.Config{
+			Environment: map[string]string{"FOO": "bar"},
+			Hooks: hooks.Hooks{
+				Clean: hooks.Command{"echo $FOO $APEX_ALIAS > clean.env", "cat clean.env > clean.copy"},
+			},
+		},
+	}
+
+	assert.NoError(t, fn.Clean())
+	assert.Equal(t, "bar current\n", hookEnv(t, filepath.Join(dir, "clean.copy")))
+}
+
+func TestFunction_Clean_hookTimeout(t *testing.T) {
+	fn := &function.Function{
+		Name:    "testfn",
+		Path:    os.TempDir(),
+		Log:     log.Log,
+		Plugins: []string{"hooks"},
+		Config: function.Config{
+			Hooks: hooks.Hooks{
+				Clean:    hooks.Command{"echo started; sleep 10"},
+				Timeouts: map[string]int{"clean": 1},
+			},
+		},
+	}
+
+	start := time.Now()
+	err := fn.Clean()
+	assert.True(t, time.Since(start) < 5*time.Second, "killed")
+
+	e, ok := err.(*hookplugin.HookError)
+	assert.True(t, ok, "hook error")
+	assert.True(t, e.TimedOut)
+	assert.Equal(t, "started", e.Output)
+}
+
+func TestFunction_Clean_hookExitCode(t *testing.T) {
+	fn := &function.Function{
+		Name:    "testfn",
+		Path:    os.TempDir(),
+		Log:     log.Log,
+		Plugins: []string{"hooks"},
+		Config: function.Config{
+			Hooks: hooks.Hooks{
+				Clean: hooks.Command{"echo failed; exit 3", "echo not run"},
+			},
+		},
+	}
+
+	e, ok := fn.Clean().(*hookplugin.HookError)
+	assert.True(t, ok, "hook error")
+	assert.Equal(t, 3, e.ExitCode)
+	assert.Equal(t, "echo failed; exit 3", e.Command)
+	assert.Equal(t, "failed", e.Output)
+}
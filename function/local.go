@@ -0,0 +1,330 @@
+package function
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/apex/apex/archive"
+)
+
+// LocalVersion is the version reported by local invocations.
+const LocalVersion = "$LOCAL"
+
+// maxResponseSize is the largest response line accepted from a local process.
+const maxResponseSize = 6 << 20
+
+// localRequest is written to the local process, following the
+// newline-delimited JSON protocol of the shim.
+type localRequest struct {
+	ID       string      `json:"id"`
+	Event    interface{} `json:"event"`
+	Context  interface{} `json:"context"`
+	Deadline int64       `json:"deadline"`
+}
+
+// localResponse is read from the local process, following the
+// newline-delimited JSON protocol of the shim.
+type localResponse struct {
+	ID    string          `json:"id"`
+	Error json.RawMessage `json:"error"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Local invokes a function build in local processes.
+type Local struct {
+	fn  *Function
+	dir string
+}
+
+// Local builds the function and unpacks it to a temporary directory,
+// the returned Local must be closed to remove it.
+func (f *Function) Local() (*Local, error) {
+	zip, err := f.BuildBytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "building")
+	}
+
+	if err := f.Clean(); err != nil {
+		return nil, errors.Wrap(err, "cleaning")
+	}
+
+	dir, err := ioutil.TempDir("", "apex-"+f.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	f.Log.Debugf("unpacking build to %s", dir)
+
+	if err := archive.Unzip(zip, dir); err != nil {
+		os.RemoveAll(dir)
+		return nil, errors.Wrap(err, "unpacking")
+	}
+
+	return &Local{fn: f, dir: dir}, nil
+}
+
+// Close removes the unpacked build.
+func (l *Local) Close() error {
+	return os.RemoveAll(l.dir)
+}
+
+// Invoke the function in a local process, returning the response and logs in the same
+// shape as Function.Invoke. The configured timeout and memory are enforced. On Linux the
+// process is killed once its resident memory exceeds the limit, on other platforms its
+// peak resident memory is only checked once it exits, where the platform reports it.
+func (l *Local) Invoke(event, context interface{}) (reply, logs io.Reader, err error) {
+	f := l.fn
+
+	cmd, err := f.hookRun(l.dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cmd == nil {
+		return nil, nil, fmt.Errorf("local invoke is not supported for runtime %q", f.Runtime)
+	}
+
+	id := requestID()
+	timeout := time.Duration(f.Timeout) * time.Second
+	start := time.Now()
+
+	req, err := json.Marshal(localRequest{
+		ID:       id,
+		Event:    event,
+		Context:  context,
+		Deadline: start.Add(timeout).UnixNano() / int64(time.Millisecond),
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := new(lockedBuffer)
+	cmd.Dir = l.dir
+	cmd.Env = l.env()
+	cmd.Stderr = out
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f.Log.WithField("command", cmd.Args).Debug("starting local process")
+
+	if err := cmd.Start(); err != nil {
+		return nil, nil, errors.Wrap(err, "starting local process")
+	}
+
+	responses := make(chan *localResponse, 1)
+	go func() {
+		responses <- readResponse(stdout, id, out)
+	}()
+
+	if _, err := stdin.Write(append(req, '\n')); err != nil {
+		f.Log.WithError(err).Debug("writing request")
+	}
+
+	stop := make(chan struct{})
+	exceeded := make(chan int64, 1)
+	go watchMemory(cmd.Process.Pid, f.Memory, stop, exceeded)
+
+	var res *localResponse
+	var timedOut bool
+	var memory int64
+
+	select {
+	case res = <-responses:
+	case <-time.After(timeout):
+		timedOut = true
+	case memory = <-exceeded:
+	}
+
+	close(stop)
+
+	// The process is discarded once it responds, much like Lambda freezing the container.
+	stdin.Close()
+	cmd.Process.Kill()
+	cmd.Wait()
+
+	duration := time.Since(start)
+	if peak := maxMemory(cmd.ProcessState); peak > memory {
+		memory = peak
+	}
+
+	logs = l.logs(id, out.Bytes(), duration, memory)
+
+	switch {
+	case timedOut:
+		return nil, logs, &InvokeError{
+			Message: fmt.Sprintf("%s %s Task timed out after %d.00 seconds", start.UTC().Format(time.RFC3339), id, f.Timeout),
+		}
+	case memory > f.Memory:
+		return nil, logs, &InvokeError{
+			Message: fmt.Sprintf("Process exceeded memory limit of %d MB (%d MB used)", f.Memory, memory),
+		}
+	case res == nil:
+		return nil, logs, &InvokeError{
+			Message: "Process exited before completing request",
+		}
+	case len(res.Error) > 0 && string(res.Error) != "null":
+		return nil, logs, invokeError(res.Error)
+	}
+
+	if len(res.Value) == 0 {
+		res.Value = []byte("null")
+	}
+
+	return bytes.NewReader(res.Value), logs, nil
+}
+
+// memoryInterval is the interval between checks of the memory of local processes.
+const memoryInterval = 20 * time.Millisecond
+
+// watchMemory sends the resident memory of process `pid` on `exceeded` once it
+// exceeds `limit` megabytes, until `stop` is closed or the platform doesn't
+// report the memory of running processes.
+func watchMemory(pid int, limit int64, stop <-chan struct{}, exceeded chan<- int64) {
+	ticker := time.NewTicker(memoryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			memory, ok := residentMemory(pid)
+			if !ok {
+				return
+			}
+
+			if memory > limit {
+				exceeded <- memory
+				return
+			}
+		}
+	}
+}
+
+// env returns the environment of the local process.
+func (l *Local) env() []string {
+	f := l.fn
+	env := append(os.Environ(), environ(f.environment().Variables)...)
+
+	return append(env,
+		"AWS_LAMBDA_FUNCTION_NAME="+f.FunctionName,
+		"AWS_LAMBDA_FUNCTION_VERSION="+LocalVersion,
+		fmt.Sprintf("AWS_LAMBDA_FUNCTION_MEMORY_SIZE=%d", f.Memory),
+		"LAMBDA_TASK_ROOT="+l.dir,
+	)
+}
+
+// logs returns the process output wrapped in Lambda's START, END and REPORT lines.
+func (l *Local) logs(id string, output []byte, duration time.Duration, memory int64) io.Reader {
+	buf := new(bytes.Buffer)
+	ms := float64(duration) / float64(time.Millisecond)
+
+	fmt.Fprintf(buf, "START RequestId: %s Version: %s\n", id, LocalVersion)
+	buf.Write(output)
+	fmt.Fprintf(buf, "END RequestId: %s\n", id)
+	fmt.Fprintf(buf, "REPORT RequestId: %s\tDuration: %.2f ms\tMemory Size: %d MB\tMax Memory Used: %d MB\t\n", id, ms, l.fn.Memory, memory)
+
+	return buf
+}
+
+// readResponse reads lines from `r` until the response for `id` is found, any other
+// output is treated as logs. Nil is returned if the process exits without responding.
+func readResponse(r io.Reader, id string, logs io.Writer) *localResponse {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64<<10), maxResponseSize)
+
+	for s.Scan() {
+		var res localResponse
+
+		if err := json.Unmarshal(s.Bytes(), &res); err != nil || res.ID != id {
+			fmt.Fprintf(logs, "%s\n", s.Bytes())
+			continue
+		}
+
+		return &res
+	}
+
+	return nil
+}
+
+// invokeError returns an InvokeError from the error of a response,
+// which is either a message or an error object.
+func invokeError(b []byte) *InvokeError {
+	e := &InvokeError{Handled: true}
+
+	var msg string
+	if err := json.Unmarshal(b, &msg); err == nil {
+		e.Message = msg
+		return e
+	}
+
+	if err := json.Unmarshal(b, e); err != nil {
+		e.Message = string(b)
+	}
+
+	return e
+}
+
+// requestID returns a random request id.
+func requestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// hookRun calls Runners, returning the first command provided.
+func (f *Function) hookRun(dir string) (*exec.Cmd, error) {
+	for _, name := range f.Plugins {
+		if p, ok := plugin(name).(Runner); ok {
+			cmd, err := p.Run(f, dir)
+			if err != nil {
+				return nil, err
+			}
+
+			if cmd != nil {
+				return cmd, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// lockedBuffer is a buffer safe for concurrent writes.
+type lockedBuffer struct {
+	sync.Mutex
+	buf bytes.Buffer
+}
+
+// Write implements io.Writer.
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.Lock()
+	defer b.Unlock()
+	return b.buf.Write(p)
+}
+
+// Bytes returns the buffered bytes.
+func (b *lockedBuffer) Bytes() []byte {
+	b.Lock()
+	defer b.Unlock()
+	return b.buf.Bytes()
+}
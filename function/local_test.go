@@ -0,0 +1,97 @@
+package function_test
+
+import (
+	"io/ioutil"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+)
+
+func localNodeFunction(t *testing.T) *function.Function {
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("node is not installed")
+	}
+
+	fn := &function.Function{
+		Config: function.Config{
+			Runtime: "nodejs",
+			Memory:  128,
+			Timeout: 1,
+			Role:    "iamrole",
+		},
+		Path: "_fixtures/nodejsLocal",
+		Name: "local",
+		Log:  log.Log,
+	}
+
+	assert.NoError(t, fn.Open(""))
+	return fn
+}
+
+func TestLocal_Invoke_reply(t *testing.T) {
+	l, err := localNodeFunction(t).Local()
+	assert.NoError(t, err)
+	defer l.Close()
+
+	reply, logs, err := l.Invoke(map[string]interface{}{"value": "tobi"}, nil)
+	assert.NoError(t, err)
+
+	b, _ := ioutil.ReadAll(reply)
+	assert.Equal(t, `{"value":"TOBI"}`, string(b))
+
+	b, _ = ioutil.ReadAll(logs)
+	assert.Contains(t, string(b), "START RequestId:")
+	assert.Contains(t, string(b), "processing tobi\n")
+	assert.Contains(t, string(b), "REPORT RequestId:")
+}
+
+func TestLocal_Invoke_error(t *testing.T) {
+	l, err := localNodeFunction(t).Local()
+	assert.NoError(t, err)
+	defer l.Close()
+
+	_, _, err = l.Invoke(map[string]interface{}{"value": "tobi", "fail": true}, nil)
+
+	e, ok := err.(*function.InvokeError)
+	assert.True(t, ok)
+	assert.Equal(t, "boom", e.Message)
+	assert.Equal(t, "Error", e.Type)
+	assert.True(t, e.Handled)
+}
+
+func TestLocal_Invoke_timeout(t *testing.T) {
+	l, err := localNodeFunction(t).Local()
+	assert.NoError(t, err)
+	defer l.Close()
+
+	_, _, err = l.Invoke(map[string]interface{}{"value": "tobi", "hang": true}, nil)
+
+	e, ok := err.(*function.InvokeError)
+	assert.True(t, ok)
+	assert.Contains(t, e.Message, "Task timed out after 1.00 seconds")
+	assert.False(t, e.Handled)
+}
+
+func TestLocal_Invoke_memory(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("memory of running processes is only enforced on linux")
+	}
+
+	l, err := localNodeFunction(t).Local()
+	assert.NoError(t, err)
+	defer l.Close()
+
+	start := time.Now()
+	_, _, err = l.Invoke(map[string]interface{}{"value": "tobi", "grow": true}, nil)
+
+	e, ok := err.(*function.InvokeError)
+	assert.True(t, ok)
+	assert.Contains(t, e.Message, "Process exceeded memory limit of 128 MB")
+	assert.True(t, time.Since(start) < time.Second, "killed before the timeout")
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package function
+
+// residentMemory returns false as the memory of running processes is only
+// reported on Linux, elsewhere the peak memory is checked once they exit.
+func residentMemory(pid int) (int64, bool) {
+	return 0, false
+}
@@ -0,0 +1,28 @@
+//go:build !windows
+
+package function
+
+import (
+	"os"
+	"runtime"
+	"syscall"
+)
+
+// maxMemory returns the peak resident memory of the exited process in megabytes.
+func maxMemory(state *os.ProcessState) int64 {
+	if state == nil {
+		return 0
+	}
+
+	ru, ok := state.SysUsage().(*syscall.Rusage)
+	if !ok {
+		return 0
+	}
+
+	// Darwin reports bytes, other platforms kilobytes.
+	if runtime.GOOS == "darwin" {
+		return int64(ru.Maxrss) >> 20
+	}
+
+	return int64(ru.Maxrss) >> 10
+}
@@ -0,0 +1,8 @@
+package function
+
+import "os"
+
+// maxMemory returns zero as peak memory is not reported on Windows.
+func maxMemory(state *os.ProcessState) int64 {
+	return 0
+}
@@ -1,6 +1,11 @@
 package function
 
-import "github.com/apex/apex/archive"
+import (
+	"os/exec"
+	"sync"
+
+	"github.com/apex/apex/archive"
+)
 
 // A Plugin is a chunk of isolated(ish) logic which reacts to various
 // hooks within the system in order to implement specific features
@@ -27,10 +32,57 @@ type Deployer interface {
 	Deploy(*Function) error
 }
 
-// Registered plugins.
-var plugins = make(map[string]Plugin)
+// PreBuilder reacts to the PreBuild hook, before the Build hook.
+type PreBuilder interface {
+	PreBuild(*Function) error
+}
+
+// PostBuilder reacts to the PostBuild hook, after the zip is created.
+type PostBuilder interface {
+	PostBuild(*Function) error
+}
+
+// PostDeployer reacts to the PostDeploy hook, after the alias is moved
+// to the deployed version.
+type PostDeployer interface {
+	PostDeploy(*Function, *HookContext) error
+}
+
+// FailureHandler reacts to the OnFailure hook, after a deploy fails.
+type FailureHandler interface {
+	OnFailure(*Function, *HookContext) error
+}
+
+// Rollbacker reacts to the Rollback hook, after the alias is moved
+// back to a previous version.
+type Rollbacker interface {
+	Rollback(*Function, *HookContext) error
+}
+
+// Runner reacts to the local Invoke hook, returning the command which runs
+// the handler from the build unpacked in `dir`, or nil if the runtime
+// is not handled by the plugin.
+type Runner interface {
+	Run(*Function, string) (*exec.Cmd, error)
+}
+
+// Registered plugins, guarded by pluginsMu as external
+// plugins are registered while functions are loaded concurrently.
+var (
+	plugins   = make(map[string]Plugin)
+	pluginsMu sync.RWMutex
+)
 
 // RegisterPlugin registers `plugin` by `name`.
 func RegisterPlugin(name string, plugin Plugin) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
 	plugins[name] = plugin
 }
+
+// plugin returns the plugin registered by `name`, or nil.
+func plugin(name string) Plugin {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+	return plugins[name]
+}
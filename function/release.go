@@ -0,0 +1,132 @@
+package function
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+// releasePrefix marks version descriptions written by deploys.
+const releasePrefix = "apex:"
+
+// Release records who deployed a version, from which commit and to which
+// alias and environment. It is stored in the description of each version
+// published by a deploy.
+type Release struct {
+	Version     string    `json:"version,omitempty"`
+	Alias       string    `json:"alias"`
+	Commit      string    `json:"commit,omitempty"`
+	User        string    `json:"user,omitempty"`
+	Environment string    `json:"environment,omitempty"`
+	Time        time.Time `json:"time"`
+}
+
+// description returns the release encoded as a version description.
+func (r *Release) description() string {
+	v := url.Values{}
+	v.Set("alias", r.Alias)
+	v.Set("commit", r.Commit)
+	v.Set("user", r.User)
+	v.Set("env", r.Environment)
+	v.Set("time", r.Time.UTC().Format(time.RFC3339))
+	return releasePrefix + v.Encode()
+}
+
+// parseRelease returns the release of `version`, or nil when it was not published by a deploy.
+func parseRelease(version *lambda.FunctionConfiguration) *Release {
+	s := aws.StringValue(version.Description)
+	if !strings.HasPrefix(s, releasePrefix) {
+		return nil
+	}
+
+	v, err := url.ParseQuery(strings.TrimPrefix(s, releasePrefix))
+	if err != nil {
+		return nil
+	}
+
+	t, _ := time.Parse(time.RFC3339, v.Get("time"))
+
+	return &Release{
+		Version:     *version.Version,
+		Alias:       v.Get("alias"),
+		Commit:      v.Get("commit"),
+		User:        v.Get("user"),
+		Environment: v.Get("env"),
+		Time:        t,
+	}
+}
+
+// publish a version of `codeSha256` described by the release, returning the version published.
+func (f *Function) publish(codeSha256 *string) (string, error) {
+	params := &lambda.PublishVersionInput{
+		FunctionName: &f.FunctionName,
+		CodeSha256:   codeSha256,
+	}
+
+	if f.Release != nil {
+		r := *f.Release
+		r.Alias = f.Alias
+		r.Time = time.Now()
+		params.Description = aws.String(r.description())
+	}
+
+	published, err := f.Service.PublishVersion(params)
+	if err != nil {
+		return "", err
+	}
+
+	return *published.Version, nil
+}
+
+// History returns the releases of the retained versions, most recent first.
+func (f *Function) History() ([]*Release, error) {
+	versions, err := f.Versions()
+	if err != nil {
+		return nil, err
+	}
+
+	var releases []*Release
+	for i := len(versions) - 1; i >= 0; i-- {
+		if r := parseRelease(versions[i]); r != nil {
+			releases = append(releases, r)
+		}
+	}
+
+	return releases, nil
+}
+
+// RollbackCommit the function to the most recent version released from `commit`,
+// which may be abbreviated, preferring versions released to the function's alias.
+func (f *Function) RollbackCommit(commit string) error {
+	releases, err := f.History()
+	if err != nil {
+		return err
+	}
+
+	var match *Release
+	for _, r := range releases {
+		if r.Commit == "" || !strings.HasPrefix(r.Commit, commit) {
+			continue
+		}
+
+		if r.Alias == f.Alias {
+			match = r
+			break
+		}
+
+		if match == nil {
+			match = r
+		}
+	}
+
+	if match == nil {
+		return fmt.Errorf("no version released from commit %s", commit)
+	}
+
+	f.Log.Debugf("commit %s released as version %s", commit, match.Version)
+	return f.RollbackVersion(match.Version)
+}
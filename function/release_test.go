@@ -0,0 +1,118 @@
+package function_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/mock"
+)
+
+func TestFunction_Update_release(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+	retainedVersions := 1
+
+	var description string
+
+	serviceMock.EXPECT().UpdateFunctionCode(gomock.Any()).Return(&lambda.FunctionConfiguration{
+		CodeSha256: aws.String("sha"),
+	}, nil)
+	serviceMock.EXPECT().WaitUntilFunctionUpdated(gomock.Any()).Return(nil)
+	serviceMock.EXPECT().PublishVersion(gomock.Any()).Do(func(in *lambda.PublishVersionInput) {
+		description = *in.Description
+	}).Return(&lambda.FunctionConfiguration{Version: aws.String("2")}, nil)
+	serviceMock.EXPECT().CreateAlias(gomock.Any()).Return(&lambda.AliasConfiguration{}, nil)
+	serviceMock.EXPECT().ListVersionsByFunction(gomock.Any()).Return(&lambda.ListVersionsByFunctionOutput{
+		Versions: []*lambda.FunctionConfiguration{{}},
+	}, nil)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Service:      serviceMock,
+		Log:          log.Log,
+		Alias:        "current",
+		Release: &function.Release{
+			Commit:      "3f2c1a9e",
+			User:        "tj",
+			Environment: "prod",
+		},
+		Config: function.Config{
+			RetainedVersions: &retainedVersions,
+		},
+	}
+
+	assert.NoError(t, fn.Update([]byte("something")))
+	assert.True(t, strings.HasPrefix(description, "apex:alias=current&commit=3f2c1a9e&env=prod&time="), description)
+	assert.True(t, strings.HasSuffix(description, "&user=tj"), description)
+}
+
+func TestFunction_History(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().ListVersionsByFunction(gomock.Any()).Return(&lambda.ListVersionsByFunctionOutput{
+		Versions: []*lambda.FunctionConfiguration{
+			{Version: aws.String("$LATEST")},
+			{Version: aws.String("1"), Description: aws.String("some function")},
+			{Version: aws.String("2"), Description: aws.String("apex:alias=current&commit=aaa111&env=prod&time=2020-01-01T00%3A00%3A00Z&user=tj")},
+			{Version: aws.String("3"), Description: aws.String("apex:alias=canary&commit=bbb222&env=prod&time=2020-01-02T00%3A00%3A00Z&user=tj")},
+		},
+	}, nil)
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Service:      serviceMock,
+		Log:          log.Log,
+	}
+
+	releases, err := fn.History()
+	assert.NoError(t, err)
+	assert.Len(t, releases, 2)
+	assert.Equal(t, "3", releases[0].Version)
+	assert.Equal(t, "canary", releases[0].Alias)
+	assert.Equal(t, "2", releases[1].Version)
+	assert.Equal(t, "aaa111", releases[1].Commit)
+	assert.Equal(t, "tj", releases[1].User)
+	assert.Equal(t, "prod", releases[1].Environment)
+	assert.Equal(t, 2020, releases[1].Time.Year())
+}
+
+func TestFunction_RollbackCommit(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	serviceMock.EXPECT().ListVersionsByFunction(gomock.Any()).Return(&lambda.ListVersionsByFunctionOutput{
+		Versions: []*lambda.FunctionConfiguration{
+			{Version: aws.String("$LATEST")},
+			{Version: aws.String("1"), Description: aws.String("apex:alias=current&commit=aaa111")},
+			{Version: aws.String("2"), Description: aws.String("apex:alias=canary&commit=aaa111")},
+			{Version: aws.String("3"), Description: aws.String("apex:alias=current&commit=bbb222")},
+		},
+	}, nil).Times(2)
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{FunctionVersion: aws.String("3")}, nil)
+	serviceMock.EXPECT().UpdateAlias(&lambda.UpdateAliasInput{
+		FunctionName:    aws.String("testfn"),
+		Name:            aws.String("current"),
+		FunctionVersion: aws.String("1"),
+	})
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Log:          log.Log,
+	}
+
+	assert.NoError(t, fn.RollbackCommit("aaa"))
+	assert.EqualError(t, (&function.Function{Service: serviceMock, Log: log.Log}).RollbackCommit("ccc"), "no version released from commit ccc")
+}
@@ -0,0 +1,83 @@
+package function
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+)
+
+// MaxInlineSize is the largest zip which may be uploaded directly to Lambda.
+const MaxInlineSize = 50 << 20
+
+// BucketRegion is replaced by the region of the function in its s3Bucket,
+// as Lambda requires the bucket to be in the same region as the function.
+const BucketRegion = "{{.Region}}"
+
+// code returns the function code for `zip`, which is uploaded to S3
+// when a bucket is configured, otherwise it's sent inline.
+func (f *Function) code(zip []byte) (*lambda.FunctionCode, error) {
+	if f.S3Bucket == "" {
+		if len(zip) > MaxInlineSize {
+			return nil, fmt.Errorf("zip of %s exceeds the %s inline upload limit, configure an s3Bucket to deploy it", humanize.IBytes(uint64(len(zip))), humanize.IBytes(MaxInlineSize))
+		}
+
+		return &lambda.FunctionCode{
+			ZipFile: zip,
+		}, nil
+	}
+
+	key, err := f.upload(zip)
+	if err != nil {
+		return nil, errors.Wrap(err, "uploading to s3")
+	}
+
+	return &lambda.FunctionCode{
+		S3Bucket: &f.S3Bucket,
+		S3Key:    &key,
+	}, nil
+}
+
+// upload `zip` to the configured bucket keyed by its SHA256, reusing
+// the existing object when already uploaded, and returns the key.
+func (f *Function) upload(zip []byte) (string, error) {
+	key := path.Join(f.S3Prefix, f.FunctionName, fmt.Sprintf("%x.zip", sha256.Sum256(zip)))
+
+	log := f.Log.WithField("key", fmt.Sprintf("s3://%s/%s", f.S3Bucket, key))
+
+	_, err := f.S3.HeadObject(&s3.HeadObjectInput{
+		Bucket: &f.S3Bucket,
+		Key:    &key,
+	})
+
+	if err == nil {
+		log.Info("code already uploaded")
+		return key, nil
+	}
+
+	if e, ok := err.(awserr.RequestFailure); !ok || e.StatusCode() != 404 {
+		return "", err
+	}
+
+	log.Infof("uploading code (%s)", humanize.Bytes(uint64(len(zip))))
+
+	_, err = f.S3.PutObject(&s3.PutObjectInput{
+		Bucket:      &f.S3Bucket,
+		Key:         &key,
+		Body:        bytes.NewReader(zip),
+		ContentType: aws.String("application/zip"),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
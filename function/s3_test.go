@@ -0,0 +1,95 @@
+package function_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/mock"
+)
+
+// fakeS3 records uploaded objects.
+type fakeS3 struct {
+	s3iface.S3API
+	objects map[string]bool
+}
+
+func (f *fakeS3) HeadObject(in *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	if f.objects[*in.Key] {
+		return &s3.HeadObjectOutput{}, nil
+	}
+
+	return nil, awserr.NewRequestFailure(awserr.New("NotFound", "Not Found", nil), 404, "")
+}
+
+func (f *fakeS3) PutObject(in *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	f.objects[*in.Key] = true
+	return &s3.PutObjectOutput{}, nil
+}
+
+func TestFunction_Update_s3(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+	zip := []byte("something")
+	key := fmt.Sprintf("builds/testfn/%x.zip", sha256.Sum256(zip))
+	retainedVersions := 1
+
+	serviceMock.EXPECT().UpdateFunctionCode(&lambda.UpdateFunctionCodeInput{
+		FunctionName:  aws.String("testfn"),
+		S3Bucket:      aws.String("bucket"),
+		S3Key:         &key,
+		Architectures: aws.StringSlice([]string{"x86_64"}),
+	}).Return(&lambda.FunctionConfiguration{
+		Version: aws.String("$LATEST"),
+	}, nil).Times(2)
+	serviceMock.EXPECT().WaitUntilFunctionUpdated(gomock.Any()).Return(nil).Times(2)
+	serviceMock.EXPECT().PublishVersion(gomock.Any()).Return(&lambda.FunctionConfiguration{
+		Version: aws.String("1"),
+	}, nil).Times(2)
+	serviceMock.EXPECT().CreateAlias(gomock.Any()).Return(&lambda.AliasConfiguration{}, nil).Times(2)
+	serviceMock.EXPECT().ListVersionsByFunction(gomock.Any()).Return(&lambda.ListVersionsByFunctionOutput{
+		Versions: []*lambda.FunctionConfiguration{{}},
+	}, nil).Times(2)
+
+	s3 := &fakeS3{objects: make(map[string]bool)}
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Service:      serviceMock,
+		S3:           s3,
+		Log:          log.Log,
+		Alias:        "current",
+		Config: function.Config{
+			RetainedVersions: &retainedVersions,
+			S3Bucket:         "bucket",
+			S3Prefix:         "builds",
+		},
+	}
+
+	assert.NoError(t, fn.Update(zip))
+	assert.True(t, s3.objects[key])
+
+	// the existing object is reused
+	assert.NoError(t, fn.Update(zip))
+}
+
+func TestFunction_Update_inlineLimit(t *testing.T) {
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Log:          log.Log,
+	}
+
+	err := fn.Update(make([]byte, function.MaxInlineSize+1))
+	assert.Contains(t, err.Error(), "exceeds the 50 MiB inline upload limit")
+}
@@ -0,0 +1,127 @@
+package function
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+)
+
+// Secret reference prefixes of environment variable values.
+const (
+	ssmPrefix            = "ssm:"
+	secretsManagerPrefix = "secretsmanager:"
+)
+
+// isSecret returns true if `value` references an SSM parameter or Secrets Manager secret.
+func isSecret(value string) bool {
+	return strings.HasPrefix(value, ssmPrefix) || strings.HasPrefix(value, secretsManagerPrefix)
+}
+
+// HasSecrets returns true if any environment variable references a secret.
+func (f *Function) HasSecrets() bool {
+	for _, v := range f.Environment {
+		if isSecret(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResolveSecrets replaces environment variable values referencing secrets,
+// such as "ssm:/app/db_password" or "secretsmanager:prod/api#key", with
+// their values. Values are never logged.
+func (f *Function) ResolveSecrets() error {
+	var keys []string
+	for k, v := range f.Environment {
+		if isSecret(v) {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		ref := f.Environment[k]
+		f.Log.WithField("name", k).Debugf("resolving %s", ref)
+
+		value, err := f.secret(ref)
+		if err != nil {
+			return errors.Wrapf(err, "resolving %s", k)
+		}
+
+		f.Environment[k] = value
+	}
+
+	return nil
+}
+
+// secret returns the value of the secret referenced by `ref`.
+func (f *Function) secret(ref string) (string, error) {
+	if strings.HasPrefix(ref, ssmPrefix) {
+		if f.SSM == nil {
+			return "", errors.New("ssm service not configured")
+		}
+
+		res, err := f.SSM.GetParameter(&ssm.GetParameterInput{
+			Name:           aws.String(strings.TrimPrefix(ref, ssmPrefix)),
+			WithDecryption: aws.Bool(true),
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		return *res.Parameter.Value, nil
+	}
+
+	if f.SecretsManager == nil {
+		return "", errors.New("secretsmanager service not configured")
+	}
+
+	id := strings.TrimPrefix(ref, secretsManagerPrefix)
+	var key string
+
+	if i := strings.LastIndex(id, "#"); i != -1 {
+		id, key = id[:i], id[i+1:]
+	}
+
+	res, err := f.SecretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: &id,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if res.SecretString == nil {
+		return "", fmt.Errorf("secret %s is binary", id)
+	}
+
+	if key == "" {
+		return *res.SecretString, nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(*res.SecretString), &fields); err != nil {
+		return "", fmt.Errorf("secret %s is not a JSON object", id)
+	}
+
+	v, ok := fields[key]
+	if !ok {
+		return "", fmt.Errorf("secret %s has no key %q", id, key)
+	}
+
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+
+	b, err := json.Marshal(v)
+	return string(b), err
+}
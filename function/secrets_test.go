@@ -0,0 +1,90 @@
+package function_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+)
+
+// fakeSSM returns `params` decrypted.
+type fakeSSM struct {
+	ssmiface.SSMAPI
+	params map[string]string
+}
+
+func (f *fakeSSM) GetParameter(in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+	if !*in.WithDecryption {
+		return nil, errors.New("not decrypted")
+	}
+
+	v, ok := f.params[*in.Name]
+	if !ok {
+		return nil, errors.New("ParameterNotFound")
+	}
+
+	return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String(v)}}, nil
+}
+
+// fakeSecretsManager returns `secrets`.
+type fakeSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+	secrets map[string]string
+}
+
+func (f *fakeSecretsManager) GetSecretValue(in *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+	v, ok := f.secrets[*in.SecretId]
+	if !ok {
+		return nil, errors.New("ResourceNotFoundException")
+	}
+
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(v)}, nil
+}
+
+func TestFunction_ResolveSecrets(t *testing.T) {
+	fn := &function.Function{
+		Config: function.Config{
+			Environment: map[string]string{
+				"PLAIN":       "value",
+				"DB_PASSWORD": "ssm:/app/db_password",
+				"API_KEY":     "secretsmanager:prod/api#key",
+				"API":         "secretsmanager:prod/api",
+			},
+		},
+		SSM:            &fakeSSM{params: map[string]string{"/app/db_password": "hunter2"}},
+		SecretsManager: &fakeSecretsManager{secrets: map[string]string{"prod/api": `{"key":"s3cret"}`}},
+		Log:            log.Log,
+	}
+
+	assert.True(t, fn.HasSecrets())
+	assert.Nil(t, fn.ResolveSecrets())
+	assert.False(t, fn.HasSecrets())
+	assert.Equal(t, map[string]string{
+		"PLAIN":       "value",
+		"DB_PASSWORD": "hunter2",
+		"API_KEY":     "s3cret",
+		"API":         `{"key":"s3cret"}`,
+	}, fn.Environment)
+}
+
+func TestFunction_ResolveSecrets_missingKey(t *testing.T) {
+	fn := &function.Function{
+		Config: function.Config{
+			Environment: map[string]string{"API_KEY": "secretsmanager:prod/api#token"},
+		},
+		SecretsManager: &fakeSecretsManager{secrets: map[string]string{"prod/api": `{"key":"s3cret"}`}},
+		Log:            log.Log,
+	}
+
+	err := fn.ResolveSecrets()
+	assert.EqualError(t, err, `resolving API_KEY: secret prod/api has no key "token"`)
+	assert.Equal(t, "secretsmanager:prod/api#token", fn.Environment["API_KEY"])
+}
@@ -0,0 +1,437 @@
+package function
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/pkg/errors"
+)
+
+// scheduleTarget is the id of the function target of schedule rules.
+const scheduleTarget = "apex"
+
+// ruleNameMax is the maximum length of rule names, longer
+// names are truncated and suffixed with a hash of the name.
+const ruleNameMax = 64
+
+// triggersTag is the prefix of the function tag marking
+// the aliases whose triggers are managed by apex.
+const triggersTag = "apex:triggers:"
+
+// Triggers of a function, when present deploys reconcile the event source
+// mappings and schedules of the function's alias, removing any not declared.
+// Removing the triggers of a function reconciles them to none.
+type Triggers struct {
+	EventSources []EventSource `json:"eventSources"`
+	Schedules    []Schedule    `json:"schedules"`
+}
+
+// EventSource is an event source mapping such as an SQS queue, Kinesis stream or DynamoDB stream.
+type EventSource struct {
+	ARN              string `json:"arn" validate:"nonzero"`
+	BatchSize        int64  `json:"batchSize"`
+	StartingPosition string `json:"startingPosition"`
+	Enabled          *bool  `json:"enabled"`
+}
+
+// enabled returns true unless explicitly disabled.
+func (e *EventSource) enabled() bool {
+	return e.Enabled == nil || *e.Enabled
+}
+
+// startingPosition returns the configured starting position, defaulting
+// to LATEST for streams, which require one.
+func (e *EventSource) startingPosition() *string {
+	if e.StartingPosition != "" {
+		return &e.StartingPosition
+	}
+
+	if strings.Contains(e.ARN, ":kinesis:") || strings.Contains(e.ARN, ":dynamodb:") {
+		return aws.String(lambda.EventSourcePositionLatest)
+	}
+
+	return nil
+}
+
+// Schedule is a CloudWatch Events rule invoking the function on a schedule.
+type Schedule struct {
+	Name       string `json:"name" validate:"nonzero"`
+	Expression string `json:"expression" validate:"nonzero"`
+	Enabled    *bool  `json:"enabled"`
+}
+
+// state returns the rule state.
+func (s *Schedule) state() string {
+	if s.Enabled == nil || *s.Enabled {
+		return cloudwatchevents.RuleStateEnabled
+	}
+
+	return cloudwatchevents.RuleStateDisabled
+}
+
+// DeployTriggers reconciles the event source mappings and schedules
+// of the function's alias against the declared triggers.
+func (f *Function) DeployTriggers() error {
+	triggers := f.Triggers
+
+	if triggers == nil {
+		if !f.triggersManaged {
+			return nil
+		}
+
+		f.Log.Info("removing triggers")
+		triggers = &Triggers{}
+	}
+
+	alias, err := f.Service.GetAlias(&lambda.GetAliasInput{
+		FunctionName: &f.FunctionName,
+		Name:         &f.Alias,
+	})
+
+	if err != nil {
+		return errors.Wrap(err, "fetching alias")
+	}
+
+	if err := f.deployEventSources(*alias.AliasArn, triggers.EventSources); err != nil {
+		return errors.Wrap(err, "event sources")
+	}
+
+	if err := f.deploySchedules(*alias.AliasArn, triggers.Schedules); err != nil {
+		return errors.Wrap(err, "schedules")
+	}
+
+	if err := f.tagTriggers(*alias.AliasArn); err != nil {
+		return errors.Wrap(err, "tagging")
+	}
+
+	return nil
+}
+
+// tagTriggers marks the triggers of the alias as managed, so that removing them
+// later reconciles them to none, or removes the mark once they are removed.
+func (f *Function) tagTriggers(target string) error {
+	arn := strings.TrimSuffix(target, ":"+f.Alias)
+	key := triggersTag + f.Alias
+
+	if f.Triggers == nil {
+		_, err := f.Service.UntagResource(&lambda.UntagResourceInput{
+			Resource: &arn,
+			TagKeys:  aws.StringSlice([]string{key}),
+		})
+
+		f.triggersManaged = err != nil
+		return err
+	}
+
+	if f.triggersManaged {
+		return nil
+	}
+
+	_, err := f.Service.TagResource(&lambda.TagResourceInput{
+		Resource: &arn,
+		Tags:     map[string]*string{key: aws.String("true")},
+	})
+
+	f.triggersManaged = err == nil
+	return err
+}
+
+// deployEventSources creates, updates and deletes event source mappings of `target`.
+func (f *Function) deployEventSources(target string, sources []EventSource) error {
+	existing, err := f.eventSourceMappings(target)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range sources {
+		log := f.Log.WithField("source", s.ARN)
+		m, ok := existing[s.ARN]
+		delete(existing, s.ARN)
+
+		if !ok {
+			log.Info("creating event source mapping")
+
+			params := &lambda.CreateEventSourceMappingInput{
+				EventSourceArn:   aws.String(s.ARN),
+				FunctionName:     &target,
+				Enabled:          aws.Bool(s.enabled()),
+				StartingPosition: s.startingPosition(),
+			}
+
+			if s.BatchSize > 0 {
+				params.BatchSize = aws.Int64(s.BatchSize)
+			}
+
+			if _, err := f.Service.CreateEventSourceMapping(params); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		enabled := *m.State != "Disabled" && *m.State != "Disabling"
+		batchChanged := s.BatchSize > 0 && s.BatchSize != aws.Int64Value(m.BatchSize)
+
+		if enabled == s.enabled() && !batchChanged {
+			log.Debug("event source mapping unchanged")
+			continue
+		}
+
+		log.Info("updating event source mapping")
+
+		params := &lambda.UpdateEventSourceMappingInput{
+			UUID:    m.UUID,
+			Enabled: aws.Bool(s.enabled()),
+		}
+
+		if batchChanged {
+			params.BatchSize = aws.Int64(s.BatchSize)
+		}
+
+		if _, err := f.Service.UpdateEventSourceMapping(params); err != nil {
+			return err
+		}
+	}
+
+	for arn, m := range existing {
+		f.Log.WithField("source", arn).Info("deleting event source mapping")
+
+		_, err := f.Service.DeleteEventSourceMapping(&lambda.DeleteEventSourceMappingInput{
+			UUID: m.UUID,
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// eventSourceMappings returns the event source mappings of `target` keyed by source ARN.
+func (f *Function) eventSourceMappings(target string) (map[string]*lambda.EventSourceMappingConfiguration, error) {
+	m := make(map[string]*lambda.EventSourceMappingConfiguration)
+	var marker *string
+
+	for {
+		list, err := f.Service.ListEventSourceMappings(&lambda.ListEventSourceMappingsInput{
+			FunctionName: &target,
+			Marker:       marker,
+		})
+
+		if e, ok := err.(awserr.Error); ok && e.Code() == "ResourceNotFoundException" {
+			return m, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range list.EventSourceMappings {
+			m[*c.EventSourceArn] = c
+		}
+
+		if list.NextMarker == nil {
+			return m, nil
+		}
+
+		marker = list.NextMarker
+	}
+}
+
+// deploySchedules puts the schedule rules targeting `target`,
+// and deletes the rules previously created for the function
+// which are no longer declared.
+func (f *Function) deploySchedules(target string, schedules []Schedule) error {
+	existing, err := f.scheduleRules(target)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range schedules {
+		name := f.ruleName(s.Name)
+		_, ok := existing[name]
+		delete(existing, name)
+
+		if err := f.putSchedule(name, s, target, ok); err != nil {
+			return err
+		}
+	}
+
+	for name := range existing {
+		if !f.ownsRule(name) {
+			continue
+		}
+
+		if err := f.deleteSchedule(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// putSchedule creates or updates the rule `name`, granting
+// CloudWatch Events permission to invoke `target` when created.
+func (f *Function) putSchedule(name string, s Schedule, target string, exists bool) error {
+	log := f.Log.WithFields(log.Fields{
+		"schedule":   name,
+		"expression": s.Expression,
+	})
+
+	if exists {
+		rule, err := f.Events.DescribeRule(&cloudwatchevents.DescribeRuleInput{
+			Name: &name,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(rule.ScheduleExpression) == s.Expression && aws.StringValue(rule.State) == s.state() {
+			log.Debug("schedule unchanged")
+			return nil
+		}
+
+		log.Info("updating schedule")
+	} else {
+		log.Info("creating schedule")
+	}
+
+	rule, err := f.Events.PutRule(&cloudwatchevents.PutRuleInput{
+		Name:               &name,
+		Description:        aws.String(fmt.Sprintf("Schedule %s of %s", s.Name, f.FunctionName)),
+		ScheduleExpression: &s.Expression,
+		State:              aws.String(s.state()),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	_, err = f.Events.PutTargets(&cloudwatchevents.PutTargetsInput{
+		Rule: &name,
+		Targets: []*cloudwatchevents.Target{
+			{
+				Id:  aws.String(scheduleTarget),
+				Arn: &target,
+			},
+		},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Service.AddPermission(&lambda.AddPermissionInput{
+		FunctionName: &f.FunctionName,
+		Qualifier:    &f.Alias,
+		StatementId:  &name,
+		Action:       aws.String("lambda:InvokeFunction"),
+		Principal:    aws.String("events.amazonaws.com"),
+		SourceArn:    rule.RuleArn,
+	})
+
+	if e, ok := err.(awserr.Error); ok && e.Code() == "ResourceConflictException" {
+		return nil
+	}
+
+	return err
+}
+
+// deleteSchedule removes the rule `name` and its invoke permission.
+func (f *Function) deleteSchedule(name string) error {
+	f.Log.WithField("schedule", name).Info("deleting schedule")
+
+	_, err := f.Events.RemoveTargets(&cloudwatchevents.RemoveTargetsInput{
+		Rule: &name,
+		Ids:  aws.StringSlice([]string{scheduleTarget}),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Events.DeleteRule(&cloudwatchevents.DeleteRuleInput{
+		Name: &name,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Service.RemovePermission(&lambda.RemovePermissionInput{
+		FunctionName: &f.FunctionName,
+		Qualifier:    &f.Alias,
+		StatementId:  &name,
+	})
+
+	if e, ok := err.(awserr.Error); ok && e.Code() == "ResourceNotFoundException" {
+		return nil
+	}
+
+	return err
+}
+
+// scheduleRules returns the names of rules targeting `target`.
+func (f *Function) scheduleRules(target string) (map[string]bool, error) {
+	names := make(map[string]bool)
+	var token *string
+
+	for {
+		list, err := f.Events.ListRuleNamesByTarget(&cloudwatchevents.ListRuleNamesByTargetInput{
+			TargetArn: &target,
+			NextToken: token,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range list.RuleNames {
+			names[*name] = true
+		}
+
+		if list.NextToken == nil {
+			return names, nil
+		}
+
+		token = list.NextToken
+	}
+}
+
+// ruleName returns the name of the rule for schedule `name`, names
+// exceeding the limit are truncated and suffixed with a short hash.
+func (f *Function) ruleName(name string) string {
+	s := fmt.Sprintf("%s_%s_%s", f.FunctionName, f.Alias, name)
+
+	if len(s) <= ruleNameMax {
+		return s
+	}
+
+	sum := sha1.Sum([]byte(s))
+	hash := hex.EncodeToString(sum[:])[:8]
+	return s[:ruleNameMax-len(hash)-1] + "_" + hash
+}
+
+// ownsRule returns true if rule `name` is named as a schedule of the function.
+func (f *Function) ownsRule(name string) bool {
+	prefix := fmt.Sprintf("%s_%s_", f.FunctionName, f.Alias)
+
+	if max := ruleNameMax - 9; len(prefix) > max {
+		prefix = prefix[:max]
+	}
+
+	return strings.HasPrefix(name, prefix)
+}
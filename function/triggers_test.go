@@ -0,0 +1,259 @@
+package function_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/mock"
+)
+
+// fakeEvents records rules put and deleted, with `rules` targeting the function.
+type fakeEvents struct {
+	cloudwatcheventsiface.CloudWatchEventsAPI
+	rules   []string
+	put     []string
+	deleted []string
+}
+
+func (f *fakeEvents) ListRuleNamesByTarget(in *cloudwatchevents.ListRuleNamesByTargetInput) (*cloudwatchevents.ListRuleNamesByTargetOutput, error) {
+	return &cloudwatchevents.ListRuleNamesByTargetOutput{RuleNames: aws.StringSlice(f.rules)}, nil
+}
+
+func (f *fakeEvents) DescribeRule(in *cloudwatchevents.DescribeRuleInput) (*cloudwatchevents.DescribeRuleOutput, error) {
+	return &cloudwatchevents.DescribeRuleOutput{Name: in.Name}, nil
+}
+
+func (f *fakeEvents) PutRule(in *cloudwatchevents.PutRuleInput) (*cloudwatchevents.PutRuleOutput, error) {
+	f.put = append(f.put, *in.Name)
+	return &cloudwatchevents.PutRuleOutput{RuleArn: aws.String("arn:rule:" + *in.Name)}, nil
+}
+
+func (f *fakeEvents) PutTargets(in *cloudwatchevents.PutTargetsInput) (*cloudwatchevents.PutTargetsOutput, error) {
+	return &cloudwatchevents.PutTargetsOutput{}, nil
+}
+
+func (f *fakeEvents) RemoveTargets(in *cloudwatchevents.RemoveTargetsInput) (*cloudwatchevents.RemoveTargetsOutput, error) {
+	return &cloudwatchevents.RemoveTargetsOutput{}, nil
+}
+
+func (f *fakeEvents) DeleteRule(in *cloudwatchevents.DeleteRuleInput) (*cloudwatchevents.DeleteRuleOutput, error) {
+	f.deleted = append(f.deleted, *in.Name)
+	return &cloudwatchevents.DeleteRuleOutput{}, nil
+}
+
+func TestFunction_DeployTriggers_eventSources(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	target := "arn:aws:lambda:us-west-2:123456789012:function:testfn:current"
+
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{AliasArn: aws.String(target)}, nil)
+	serviceMock.EXPECT().ListEventSourceMappings(gomock.Any()).Return(&lambda.ListEventSourceMappingsOutput{
+		EventSourceMappings: []*lambda.EventSourceMappingConfiguration{
+			{UUID: aws.String("1"), EventSourceArn: aws.String("arn:aws:sqs:us-west-2:123456789012:jobs"), BatchSize: aws.Int64(10), State: aws.String("Enabled")},
+			{UUID: aws.String("2"), EventSourceArn: aws.String("arn:aws:sqs:us-west-2:123456789012:old"), BatchSize: aws.Int64(10), State: aws.String("Enabled")},
+		},
+	}, nil)
+	serviceMock.EXPECT().UpdateEventSourceMapping(&lambda.UpdateEventSourceMappingInput{
+		UUID:      aws.String("1"),
+		Enabled:   aws.Bool(true),
+		BatchSize: aws.Int64(5),
+	})
+	serviceMock.EXPECT().CreateEventSourceMapping(&lambda.CreateEventSourceMappingInput{
+		EventSourceArn:   aws.String("arn:aws:kinesis:us-west-2:123456789012:stream/clicks"),
+		FunctionName:     aws.String(target),
+		Enabled:          aws.Bool(true),
+		StartingPosition: aws.String("LATEST"),
+	})
+	serviceMock.EXPECT().DeleteEventSourceMapping(&lambda.DeleteEventSourceMappingInput{UUID: aws.String("2")})
+	serviceMock.EXPECT().TagResource(&lambda.TagResourceInput{
+		Resource: aws.String("arn:aws:lambda:us-west-2:123456789012:function:testfn"),
+		Tags:     map[string]*string{"apex:triggers:current": aws.String("true")},
+	})
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Events:       &fakeEvents{},
+		Log:          log.Log,
+		Config: function.Config{
+			Triggers: &function.Triggers{
+				EventSources: []function.EventSource{
+					{ARN: "arn:aws:sqs:us-west-2:123456789012:jobs", BatchSize: 5},
+					{ARN: "arn:aws:kinesis:us-west-2:123456789012:stream/clicks"},
+				},
+			},
+		},
+	}
+
+	assert.Nil(t, fn.DeployTriggers())
+}
+
+func TestFunction_DeployTriggers_schedules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	target := "arn:aws:lambda:us-west-2:123456789012:function:testfn:current"
+
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{AliasArn: aws.String(target)}, nil)
+	serviceMock.EXPECT().ListEventSourceMappings(gomock.Any()).Return(&lambda.ListEventSourceMappingsOutput{}, nil)
+	serviceMock.EXPECT().AddPermission(&lambda.AddPermissionInput{
+		FunctionName: aws.String("testfn"),
+		Qualifier:    aws.String("current"),
+		StatementId:  aws.String("testfn_current_hourly"),
+		Action:       aws.String("lambda:InvokeFunction"),
+		Principal:    aws.String("events.amazonaws.com"),
+		SourceArn:    aws.String("arn:rule:testfn_current_hourly"),
+	})
+	serviceMock.EXPECT().RemovePermission(&lambda.RemovePermissionInput{
+		FunctionName: aws.String("testfn"),
+		Qualifier:    aws.String("current"),
+		StatementId:  aws.String("testfn_current_daily"),
+	})
+	serviceMock.EXPECT().TagResource(gomock.Any())
+
+	events := &fakeEvents{
+		rules: []string{"testfn_current_daily", "managed-elsewhere"},
+	}
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Events:       events,
+		Log:          log.Log,
+		Config: function.Config{
+			Triggers: &function.Triggers{
+				Schedules: []function.Schedule{
+					{Name: "hourly", Expression: "rate(1 hour)"},
+				},
+			},
+		},
+	}
+
+	assert.Nil(t, fn.DeployTriggers())
+	assert.Equal(t, []string{"testfn_current_hourly"}, events.put)
+	assert.Equal(t, []string{"testfn_current_daily"}, events.deleted)
+}
+
+func TestFunction_DeployTriggers_longRuleNames(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	name := strings.Repeat("f", 60)
+	target := "arn:aws:lambda:us-west-2:123456789012:function:" + name + ":current"
+
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{AliasArn: aws.String(target)}, nil).Times(2)
+	serviceMock.EXPECT().ListEventSourceMappings(gomock.Any()).Return(&lambda.ListEventSourceMappingsOutput{}, nil).Times(2)
+	serviceMock.EXPECT().AddPermission(gomock.Any()).Times(2)
+	serviceMock.EXPECT().TagResource(gomock.Any())
+
+	events := &fakeEvents{}
+
+	fn := &function.Function{
+		FunctionName: name,
+		Alias:        "current",
+		Service:      serviceMock,
+		Events:       events,
+		Log:          log.Log,
+		Config: function.Config{
+			Triggers: &function.Triggers{
+				Schedules: []function.Schedule{
+					{Name: "hourly", Expression: "rate(1 hour)"},
+					{Name: "daily", Expression: "rate(1 day)"},
+				},
+			},
+		},
+	}
+
+	assert.Nil(t, fn.DeployTriggers())
+	assert.Len(t, events.put, 2)
+	assert.NotEqual(t, events.put[0], events.put[1])
+
+	for _, rule := range events.put {
+		assert.Len(t, rule, 64)
+		assert.True(t, strings.HasPrefix(rule, name[:55]))
+	}
+
+	// the rules are found again by name, and the removed one deleted
+	events.rules = events.put
+	events.put = nil
+	fn.Triggers.Schedules = fn.Triggers.Schedules[:1]
+	serviceMock.EXPECT().RemovePermission(gomock.Any())
+
+	assert.Nil(t, fn.DeployTriggers())
+	assert.Equal(t, events.rules[1:], events.deleted)
+}
+
+func TestFunction_Deploy_removedTriggers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	target := "arn:aws:lambda:us-west-2:123456789012:function:testfn:current"
+
+	serviceMock.EXPECT().GetFunction(gomock.Any()).Return(&lambda.GetFunctionOutput{
+		Configuration: &lambda.FunctionConfiguration{
+			CodeSha256:  aws.String("sha"),
+			Description: aws.String(""),
+			MemorySize:  aws.Int64(128),
+			Timeout:     aws.Int64(3),
+			Role:        aws.String("iamrole"),
+			Runtime:     aws.String("nodejs"),
+			Handler:     aws.String("index.handle"),
+		},
+		Tags: map[string]*string{"apex:triggers:current": aws.String("true")},
+	}, nil)
+	serviceMock.EXPECT().UpdateFunctionConfiguration(gomock.Any())
+	serviceMock.EXPECT().WaitUntilFunctionUpdated(gomock.Any()).Times(2)
+	serviceMock.EXPECT().UpdateFunctionCode(gomock.Any()).Return(&lambda.FunctionConfiguration{}, nil)
+	serviceMock.EXPECT().PublishVersion(gomock.Any()).Return(&lambda.FunctionConfiguration{Version: aws.String("2")}, nil)
+	serviceMock.EXPECT().CreateAlias(gomock.Any())
+	serviceMock.EXPECT().ListVersionsByFunction(gomock.Any()).Return(&lambda.ListVersionsByFunctionOutput{
+		Versions: []*lambda.FunctionConfiguration{{}},
+	}, nil)
+	serviceMock.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{AliasArn: aws.String(target)}, nil)
+	serviceMock.EXPECT().ListEventSourceMappings(gomock.Any()).Return(&lambda.ListEventSourceMappingsOutput{
+		EventSourceMappings: []*lambda.EventSourceMappingConfiguration{
+			{UUID: aws.String("1"), EventSourceArn: aws.String("arn:aws:sqs:us-west-2:123456789012:jobs"), State: aws.String("Enabled")},
+		},
+	}, nil)
+	serviceMock.EXPECT().DeleteEventSourceMapping(&lambda.DeleteEventSourceMappingInput{UUID: aws.String("1")})
+	serviceMock.EXPECT().RemovePermission(gomock.Any())
+	serviceMock.EXPECT().UntagResource(&lambda.UntagResourceInput{
+		Resource: aws.String("arn:aws:lambda:us-west-2:123456789012:function:testfn"),
+		TagKeys:  aws.StringSlice([]string{"apex:triggers:current"}),
+	})
+
+	events := &fakeEvents{
+		rules: []string{"testfn_current_hourly"},
+	}
+
+	fn := &function.Function{
+		FunctionName: "testfn",
+		Alias:        "current",
+		Service:      serviceMock,
+		Events:       events,
+		Log:          log.Log,
+		Config: function.Config{
+			RetainedVersions: aws.Int(1),
+			Environment:      make(map[string]string),
+		},
+	}
+
+	assert.Nil(t, fn.DeployZip([]byte("zip")))
+	assert.Equal(t, []string{"testfn_current_hourly"}, events.deleted)
+}
@@ -0,0 +1,292 @@
+package function
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/dustin/go-humanize"
+)
+
+// Lambda limits checked by validation.
+const (
+	minMemorySize        = 128
+	maxMemorySize        = 10240
+	maxTimeout           = 900
+	maxEdgeTimeout       = 30
+	maxDescription       = 256
+	maxHandler           = 128
+	maxFunctionName      = 64
+	maxLayers            = 5
+	maxEnvironmentSize   = 4 * 1024
+	maxZipSize           = 50 * 1024 * 1024
+	maxUncompressedSize  = 250 * 1024 * 1024
+	maxEventSourceBatch  = 10000
+	reservedEnvironments = "_HANDLER _X_AMZN_TRACE_ID AWS_REGION AWS_EXECUTION_ENV AWS_LAMBDA_FUNCTION_NAME AWS_LAMBDA_FUNCTION_MEMORY_SIZE AWS_LAMBDA_FUNCTION_VERSION AWS_LAMBDA_INITIALIZATION_TYPE AWS_LAMBDA_LOG_GROUP_NAME AWS_LAMBDA_LOG_STREAM_NAME AWS_ACCESS_KEY AWS_ACCESS_KEY_ID AWS_SECRET_ACCESS_KEY AWS_SESSION_TOKEN AWS_LAMBDA_RUNTIME_API LAMBDA_TASK_ROOT LAMBDA_RUNTIME_DIR"
+)
+
+// runtimes supported by Lambda which are more recent than the SDK.
+var runtimes = []string{"nodejs22.x", "python3.13", "ruby3.4"}
+
+// Formats of configuration values.
+var (
+	functionNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	roleARNPattern      = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/.+$`)
+	kmsARNPattern       = regexp.MustCompile(`^arn:aws[a-z-]*:kms:[a-z0-9-]+:\d{12}:key/.+$`)
+	deadLetterPattern   = regexp.MustCompile(`^arn:aws[a-z-]*:(sqs|sns):[a-z0-9-]+:\d{12}:.+$`)
+	regionPattern       = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+$`)
+	envNamePattern      = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]+$`)
+	schedulePattern     = regexp.MustCompile(`^(rate|cron)\(.+\)$`)
+	dottedHandler       = regexp.MustCompile(`^[^\s]+\.[^.\s]+$`)
+	javaHandler         = regexp.MustCompile(`^[\w$.]+(::\w+)?$`)
+	dotnetHandler       = regexp.MustCompile(`^[^:\s]+::[^:\s]+::[^:\s]+$`)
+)
+
+// Problem is an invalid configuration value of a function.
+type Problem struct {
+	Function string `json:"function"`
+	File     string `json:"file,omitempty"`
+	Field    string `json:"field"`
+	Message  string `json:"message"`
+}
+
+// String implementation.
+func (p Problem) String() string {
+	if p.File == "" {
+		return fmt.Sprintf("function %s: %s: %s", p.Function, p.Field, p.Message)
+	}
+
+	return fmt.Sprintf("function %s: %s: %s: %s", p.Function, p.File, p.Field, p.Message)
+}
+
+// Problems of function configurations.
+type Problems []Problem
+
+// Error implementation.
+func (p Problems) Error() string {
+	var lines []string
+	for _, problem := range p {
+		lines = append(lines, problem.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Validate checks the configuration against Lambda limits without
+// calling AWS, returning every problem found.
+func (f *Function) Validate() Problems {
+	var problems Problems
+
+	add := func(field, format string, args ...interface{}) {
+		problems = append(problems, Problem{
+			Function: f.Name,
+			Field:    field,
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	if len(f.FunctionName) > maxFunctionName || !functionNamePattern.MatchString(f.FunctionName) {
+		add("nameTemplate", "function name %q must be at most %d letters, numbers, hyphens or underscores", f.FunctionName, maxFunctionName)
+	}
+
+	if len(f.Description) > maxDescription {
+		add("description", "must be at most %d characters", maxDescription)
+	}
+
+	if f.Memory < minMemorySize || f.Memory > maxMemorySize {
+		add("memory", "must be between %d and %d MB, got %d", minMemorySize, maxMemorySize, f.Memory)
+	}
+
+	timeout := int64(maxTimeout)
+	if f.Edge {
+		timeout = maxEdgeTimeout
+	}
+
+	if f.Timeout < 1 || f.Timeout > timeout {
+		add("timeout", "must be between 1 and %d seconds, got %d", timeout, f.Timeout)
+	}
+
+	if !f.knownRuntime() {
+		add("runtime", "unknown runtime %q", f.Runtime)
+	} else if msg := f.validateHandler(); msg != "" {
+		add("handler", "%s", msg)
+	}
+
+	if !roleARNPattern.MatchString(f.Role) {
+		add("role", "must be an IAM role ARN, got %q", f.Role)
+	}
+
+	if f.KMSKeyArn != "" && !kmsARNPattern.MatchString(f.KMSKeyArn) {
+		add("kms_arn", "must be a KMS key ARN, got %q", f.KMSKeyArn)
+	}
+
+	if f.DeadLetterARN != "" && !deadLetterPattern.MatchString(f.DeadLetterARN) {
+		add("deadletter_arn", "must be an SQS queue or SNS topic ARN, got %q", f.DeadLetterARN)
+	}
+
+	if f.Region != "" && !regionPattern.MatchString(f.Region) {
+		add("region", "unknown region %q", f.Region)
+	}
+
+	for i, region := range f.Regions {
+		if !regionPattern.MatchString(region) {
+			add(fmt.Sprintf("regions[%d]", i), "unknown region %q", region)
+		}
+	}
+
+	if strings.Contains(f.S3Bucket, BucketRegion) {
+		add("s3Bucket", "%s requires the function to specify a region or regions", BucketRegion)
+	}
+
+	if len(f.Layers) > maxLayers {
+		add("layers", "must be at most %d layers, got %d", maxLayers, len(f.Layers))
+	}
+
+	if c := f.ReservedConcurrency; c != nil && *c < 0 {
+		add("reservedConcurrency", "must not be negative")
+	}
+
+	if c := f.ProvisionedConcurrency; c != nil && *c < 0 {
+		add("provisionedConcurrency", "must not be negative")
+	}
+
+	var names []string
+	for name := range f.Environment {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	reserved := strings.Fields(reservedEnvironments)
+	for _, name := range names {
+		switch {
+		case !envNamePattern.MatchString(name):
+			add("environment."+name, "must start with a letter and contain two or more letters, numbers and underscores")
+		case contains(reserved, name):
+			add("environment."+name, "is reserved by Lambda")
+		}
+	}
+
+	if f.Triggers != nil {
+		for i, s := range f.Triggers.EventSources {
+			if !strings.HasPrefix(s.ARN, "arn:") {
+				add(fmt.Sprintf("triggers.eventSources[%d].arn", i), "must be an ARN, got %q", s.ARN)
+			}
+
+			if s.BatchSize < 0 || s.BatchSize > maxEventSourceBatch {
+				add(fmt.Sprintf("triggers.eventSources[%d].batchSize", i), "must be between 1 and %d", maxEventSourceBatch)
+			}
+		}
+
+		for i, s := range f.Triggers.Schedules {
+			if !schedulePattern.MatchString(s.Expression) {
+				add(fmt.Sprintf("triggers.schedules[%d].expression", i), "must be a rate() or cron() expression, got %q", s.Expression)
+			}
+		}
+	}
+
+	return problems
+}
+
+// ValidateEnvironment checks the size of the environment against Lambda limits,
+// once secrets and function references of the environment are resolved.
+func (f *Function) ValidateEnvironment() Problems {
+	size := 0
+	for k, v := range f.environment().Variables {
+		size += len(k) + len(*v)
+	}
+
+	if size <= maxEnvironmentSize {
+		return nil
+	}
+
+	return Problems{{
+		Function: f.Name,
+		Field:    "environment",
+		Message:  fmt.Sprintf("variables must total at most %s, got %s", humanize.IBytes(maxEnvironmentSize), humanize.IBytes(uint64(size))),
+	}}
+}
+
+// ValidateZip checks the size of the built `zip` against Lambda limits.
+func (f *Function) ValidateZip(b []byte) Problems {
+	var problems Problems
+
+	add := func(format string, args ...interface{}) {
+		problems = append(problems, Problem{
+			Function: f.Name,
+			Field:    "zip",
+			Message:  fmt.Sprintf(format, args...),
+		})
+	}
+
+	if f.S3Bucket == "" && len(b) > maxZipSize {
+		add("must be at most %s when uploaded directly, got %s, consider setting s3Bucket", humanize.IBytes(maxZipSize), humanize.IBytes(uint64(len(b))))
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		add("invalid zip: %s", err)
+		return problems
+	}
+
+	var size uint64
+	for _, file := range r.File {
+		size += file.UncompressedSize64
+	}
+
+	if size > maxUncompressedSize {
+		add("must be at most %s uncompressed, got %s", humanize.IBytes(maxUncompressedSize), humanize.IBytes(size))
+	}
+
+	return problems
+}
+
+// knownRuntime returns true if the runtime is supported by Lambda, or by a plugin.
+func (f *Function) knownRuntime() bool {
+	if plugin(f.Runtime) != nil {
+		return true
+	}
+
+	return contains(lambda.Runtime_Values(), f.Runtime) || contains(runtimes, f.Runtime)
+}
+
+// validateHandler returns a message when the handler doesn't match the runtime's format.
+func (f *Function) validateHandler() string {
+	h := f.Handler
+
+	if len(h) > maxHandler {
+		return fmt.Sprintf("must be at most %d characters", maxHandler)
+	}
+
+	switch {
+	case strings.HasPrefix(f.Runtime, "nodejs"), strings.HasPrefix(f.Runtime, "python"), strings.HasPrefix(f.Runtime, "ruby"):
+		if !dottedHandler.MatchString(h) {
+			return fmt.Sprintf("must be FILE.FUNCTION for %s, got %q", f.Runtime, h)
+		}
+	case strings.HasPrefix(f.Runtime, "java"), f.Runtime == "clojure":
+		if !javaHandler.MatchString(h) {
+			return fmt.Sprintf("must be PACKAGE.CLASS or PACKAGE.CLASS::METHOD for %s, got %q", f.Runtime, h)
+		}
+	case strings.HasPrefix(f.Runtime, "dotnet"):
+		if !dotnetHandler.MatchString(h) {
+			return fmt.Sprintf("must be ASSEMBLY::TYPE::METHOD for %s, got %q", f.Runtime, h)
+		}
+	}
+
+	return ""
+}
+
+// contains returns true if `s` is in `list`.
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,178 @@
+package function_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+)
+
+// validFunction returns a function within Lambda limits.
+func validFunction() *function.Function {
+	return &function.Function{
+		Config: function.Config{
+			Runtime: "nodejs18.x",
+			Memory:  128,
+			Timeout: 3,
+			Role:    "arn:aws:iam::123456789012:role/lambda",
+			Handler: "index.handle",
+		},
+		Name:         "foo",
+		FunctionName: "project_foo",
+		Log:          log.Log,
+	}
+}
+
+// fields returns the fields of `problems`.
+func fields(problems function.Problems) (list []string) {
+	for _, p := range problems {
+		list = append(list, p.Field)
+	}
+	return
+}
+
+func TestFunction_Validate(t *testing.T) {
+	assert.Empty(t, validFunction().Validate())
+}
+
+func TestFunction_Validate_limits(t *testing.T) {
+	fn := validFunction()
+	fn.Memory = 20000
+	fn.Timeout = 901
+	fn.Description = strings.Repeat("a", 257)
+	fn.Layers = []string{"a", "b", "c", "d", "e", "f"}
+	fn.ReservedConcurrency = aws.Int64(-1)
+
+	assert.Equal(t, []string{"description", "memory", "timeout", "layers", "reservedConcurrency"}, fields(fn.Validate()))
+}
+
+func TestFunction_Validate_edgeTimeout(t *testing.T) {
+	fn := validFunction()
+	fn.Edge = true
+	fn.Timeout = 60
+
+	problems := fn.Validate()
+	assert.Equal(t, []string{"timeout"}, fields(problems))
+	assert.Equal(t, "function foo: timeout: must be between 1 and 30 seconds, got 60", problems.Error())
+}
+
+func TestFunction_Validate_runtime(t *testing.T) {
+	fn := validFunction()
+	fn.Runtime = "nodejs99.x"
+
+	assert.Equal(t, []string{"runtime"}, fields(fn.Validate()))
+
+	fn.Runtime = "nodejs22.x"
+	assert.Empty(t, fn.Validate())
+}
+
+func TestFunction_Validate_handler(t *testing.T) {
+	cases := []struct {
+		runtime string
+		handler string
+		valid   bool
+	}{
+		{"nodejs18.x", "index.handle", true},
+		{"nodejs18.x", "index", false},
+		{"python3.12", "main.handle", true},
+		{"python3.12", "main", false},
+		{"java11", "com.example.Handler::handle", true},
+		{"java11", "com.example.Handler", true},
+		{"java11", "com example", false},
+		{"dotnet8", "Assembly::Namespace.Type::Method", true},
+		{"dotnet8", "Assembly.Method", false},
+		{"provided.al2", "bootstrap", true},
+		{"nodejs18.x", strings.Repeat("a", 127) + ".h", false},
+	}
+
+	for _, c := range cases {
+		fn := validFunction()
+		fn.Runtime = c.runtime
+		fn.Handler = c.handler
+
+		if c.valid {
+			assert.Empty(t, fn.Validate(), c.handler)
+		} else {
+			assert.Equal(t, []string{"handler"}, fields(fn.Validate()), c.handler)
+		}
+	}
+}
+
+func TestFunction_Validate_arns(t *testing.T) {
+	fn := validFunction()
+	fn.Role = "lambda"
+	fn.KMSKeyArn = "arn:aws:kms:us-west-2:123456789012:alias/foo"
+	fn.DeadLetterARN = "arn:aws:s3:::bucket"
+
+	assert.Equal(t, []string{"role", "kms_arn", "deadletter_arn"}, fields(fn.Validate()))
+
+	fn.Role = "arn:aws:iam::123456789012:role/service-role/lambda"
+	fn.KMSKeyArn = "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	fn.DeadLetterARN = "arn:aws:sqs:us-west-2:123456789012:dead"
+	assert.Empty(t, fn.Validate())
+}
+
+func TestFunction_Validate_bucketRegion(t *testing.T) {
+	fn := validFunction()
+	fn.S3Bucket = "deploys-{{.Region}}"
+
+	problems := fn.Validate()
+	assert.Equal(t, []string{"s3Bucket"}, fields(problems))
+	assert.Equal(t, "function foo: s3Bucket: {{.Region}} requires the function to specify a region or regions", problems.Error())
+}
+
+func TestFunction_Validate_environment(t *testing.T) {
+	fn := validFunction()
+	fn.Environment = map[string]string{
+		"1FOO":       "bar",
+		"A":          "bar",
+		"AWS_REGION": "us-west-2",
+		"LARGE":      strings.Repeat("a", 4096),
+	}
+
+	assert.Equal(t, []string{"environment.1FOO", "environment.A", "environment.AWS_REGION"}, fields(fn.Validate()))
+}
+
+func TestFunction_ValidateEnvironment(t *testing.T) {
+	fn := validFunction()
+	fn.Environment = map[string]string{
+		"PASSWORD": "ssm:/app/password",
+	}
+
+	assert.Empty(t, fn.ValidateEnvironment())
+
+	fn.Environment["PASSWORD"] = strings.Repeat("a", 4096)
+	assert.Equal(t, []string{"environment"}, fields(fn.ValidateEnvironment()))
+}
+
+func TestFunction_Validate_triggers(t *testing.T) {
+	fn := validFunction()
+	fn.Triggers = &function.Triggers{
+		EventSources: []function.EventSource{{ARN: "queue", BatchSize: 20000}},
+		Schedules:    []function.Schedule{{Name: "hourly", Expression: "every hour"}},
+	}
+
+	assert.Equal(t, []string{
+		"triggers.eventSources[0].arn",
+		"triggers.eventSources[0].batchSize",
+		"triggers.schedules[0].expression",
+	}, fields(fn.Validate()))
+}
+
+func TestFunction_ValidateZip(t *testing.T) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	f, _ := w.Create("index.js")
+	f.Write([]byte("exports.handle = () => {}"))
+	w.Close()
+
+	fn := validFunction()
+	assert.Empty(t, fn.ValidateZip(buf.Bytes()))
+	assert.Equal(t, []string{"zip"}, fields(fn.ValidateZip([]byte("not a zip"))))
+}
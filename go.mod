@@ -3,7 +3,7 @@ module github.com/apex/apex
 require (
 	github.com/Unknwon/goconfig v0.0.0-20161121224340-87a46d97951e
 	github.com/apex/log v1.0.0
-	github.com/aws/aws-sdk-go v1.13.52
+	github.com/aws/aws-sdk-go v1.55.8
 	github.com/aybabtme/rgbterm v0.0.0-20170906152045-cc83f3b3ce59
 	github.com/buger/goterm v0.0.0-20180423150900-6d19e6a8df12
 	github.com/c4milo/unpackit v0.0.0-20170704181138-4ed373e9ef1c
@@ -17,7 +17,7 @@ require (
 	github.com/google/go-querystring v0.0.0-20170111101155-53e6ce116135
 	github.com/howeyc/gopass v0.0.0-20170109162249-bf9dde6d0d2c
 	github.com/inconshreveable/mousetrap v1.0.0
-	github.com/jmespath/go-jmespath v0.0.0-20160202185014-0b12d6b521d8
+	github.com/jmespath/go-jmespath v0.4.0
 	github.com/klauspost/compress v1.2.1
 	github.com/klauspost/cpuid v0.0.0-20170728055534-ae7887de9fa5
 	github.com/klauspost/crc32 v0.0.0-20161016154125-cb6bfca970f6
@@ -1,13 +1,78 @@
 package hooks
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 // Hooks supported by Apex.
 type Hooks struct {
+	// PreBuild command is run before the build command.
+	PreBuild Command `json:"prebuild"`
+
 	// Build command is run before creating the zip file.
-	Build string `json:"build"`
+	Build Command `json:"build"`
+
+	// PostBuild command is run after creating the zip file.
+	PostBuild Command `json:"postbuild"`
 
 	// Clean command is run after creating the zip file.
-	Clean string `json:"clean"`
+	Clean Command `json:"clean"`
 
 	// Deploy command is run after builds and before deploys.
-	Deploy string `json:"deploy"`
+	Deploy Command `json:"deploy"`
+
+	// PostDeploy command is run after the alias is moved to the deployed version.
+	PostDeploy Command `json:"postdeploy"`
+
+	// OnFailure command is run after a deploy fails.
+	OnFailure Command `json:"onfailure"`
+
+	// Rollback command is run after the alias is moved back to a previous version.
+	Rollback Command `json:"rollback"`
+
+	// Timeouts of hooks in seconds by hook name, unlimited by default.
+	Timeouts map[string]int `json:"timeouts"`
+}
+
+// Command is a list of shell commands run in order, specified
+// in JSON as a single string or an array of strings.
+type Command []string
+
+// UnmarshalJSON implementation.
+func (c *Command) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s == "" {
+			*c = nil
+		} else {
+			*c = Command{s}
+		}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+
+	*c = list
+	return nil
+}
+
+// MarshalJSON implementation, a single command is marshalled as a string.
+func (c Command) MarshalJSON() ([]byte, error) {
+	switch len(c) {
+	case 0:
+		return json.Marshal("")
+	case 1:
+		return json.Marshal(c[0])
+	default:
+		return json.Marshal([]string(c))
+	}
+}
+
+// String implementation.
+func (c Command) String() string {
+	return strings.Join(c, " && ")
 }
@@ -0,0 +1,30 @@
+package hooks_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/hooks"
+)
+
+func TestCommand_UnmarshalJSON(t *testing.T) {
+	var h hooks.Hooks
+	err := json.Unmarshal([]byte(`{"build": "make", "clean": ["rm -f main", "rm -rf dist"], "deploy": ""}`), &h)
+	assert.NoError(t, err)
+	assert.Equal(t, hooks.Command{"make"}, h.Build)
+	assert.Equal(t, hooks.Command{"rm -f main", "rm -rf dist"}, h.Clean)
+	assert.Empty(t, h.Deploy)
+}
+
+func TestCommand_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(hooks.Hooks{
+		Build: hooks.Command{"make"},
+		Clean: hooks.Command{"rm -f main", "rm -rf dist"},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"build":"make"`)
+	assert.Contains(t, string(b), `"clean":["rm -f main","rm -rf dist"]`)
+	assert.Contains(t, string(b), `"deploy":""`)
+}
@@ -0,0 +1,159 @@
+// Package layer implements Lambda layer operations.
+package layer
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/apex/apex/archive"
+	"github.com/apex/apex/utils"
+)
+
+// Config for a Lambda layer.
+type Config struct {
+	Description string   `json:"description"`
+	Runtimes    []string `json:"runtimes"`
+	License     string   `json:"license"`
+}
+
+// Layer represents a Lambda layer, built from a directory with
+// optional configuration loaded from the "layer.json" file on disk.
+type Layer struct {
+	Config
+	Name       string
+	LayerName  string
+	Path       string
+	Service    lambdaiface.LambdaAPI
+	Log        log.Interface
+	IgnoreFile []byte
+}
+
+// Open the layer.json file, if present, and prime the config.
+func (l *Layer) Open() error {
+	l.Log = l.Log.WithField("layer", l.Name)
+	l.Log.Debug("open")
+
+	f, err := os.Open(filepath.Join(l.Path, "layer.json"))
+	if err == nil {
+		defer f.Close()
+
+		if err := json.NewDecoder(f).Decode(&l.Config); err != nil {
+			return errors.Wrap(err, "loading config")
+		}
+	}
+
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "loading config")
+	}
+
+	ignoreFile, err := utils.ReadIgnoreFile(l.Path)
+	if err != nil {
+		return errors.Wrap(err, "reading ignore file")
+	}
+
+	l.IgnoreFile = append(l.IgnoreFile, []byte("\nlayer.json\n")...)
+	l.IgnoreFile = append(l.IgnoreFile, ignoreFile...)
+
+	return nil
+}
+
+// BuildBytes returns the zipped contents of the layer.
+func (l *Layer) BuildBytes() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	zip := archive.NewZip(buf)
+
+	paths, err := utils.LoadFiles(l.Path, l.IgnoreFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := zip.AddFiles(l.Path, paths); err != nil {
+		return nil, err
+	}
+
+	if err := zip.Close(); err != nil {
+		return nil, err
+	}
+
+	l.Log.Debugf("created build (%s)", humanize.Bytes(uint64(buf.Len())))
+	return buf.Bytes(), nil
+}
+
+// Deploy builds the layer and publishes a new version when its contents
+// changed, returning the ARN of the version to use.
+func (l *Layer) Deploy() (string, error) {
+	l.Log.Debug("deploying")
+
+	zip, err := l.BuildBytes()
+	if err != nil {
+		return "", err
+	}
+
+	latest, err := l.Latest()
+	if err != nil {
+		return "", err
+	}
+
+	if latest != nil && *latest.Content.CodeSha256 == utils.Sha256(zip) {
+		l.Log.Info("layer unchanged")
+		return *latest.LayerVersionArn, nil
+	}
+
+	l.Log.Info("publishing layer")
+
+	published, err := l.Service.PublishLayerVersion(&lambda.PublishLayerVersionInput{
+		LayerName:          &l.LayerName,
+		Description:        &l.Description,
+		CompatibleRuntimes: aws.StringSlice(l.Runtimes),
+		LicenseInfo:        &l.License,
+		Content: &lambda.LayerVersionContentInput{
+			ZipFile: zip,
+		},
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	l.Log.WithFields(log.Fields{
+		"version": *published.Version,
+		"name":    l.LayerName,
+	}).Info("layer published")
+
+	return *published.LayerVersionArn, nil
+}
+
+// Latest returns the latest published version of the layer, or nil if there is none.
+func (l *Layer) Latest() (*lambda.GetLayerVersionOutput, error) {
+	list, err := l.Service.ListLayerVersions(&lambda.ListLayerVersionsInput{
+		LayerName: &l.LayerName,
+		MaxItems:  aws.Int64(1),
+	})
+
+	if e, ok := err.(awserr.Error); ok && e.Code() == "ResourceNotFoundException" {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list.LayerVersions) == 0 {
+		return nil, nil
+	}
+
+	return l.Service.GetLayerVersion(&lambda.GetLayerVersionInput{
+		LayerName:     &l.LayerName,
+		VersionNumber: list.LayerVersions[0].Version,
+	})
+}
@@ -0,0 +1,83 @@
+package layer_test
+
+import (
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/discard"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/layer"
+	"github.com/apex/apex/mock"
+	"github.com/apex/apex/utils"
+)
+
+func init() {
+	log.SetHandler(discard.New())
+}
+
+func newLayer(t *testing.T, service *mock_lambdaiface.MockLambdaAPI) *layer.Layer {
+	l := &layer.Layer{
+		Name:      "deps",
+		LayerName: "test_deps",
+		Path:      "_fixtures/deps",
+		Service:   service,
+		Log:       log.Log,
+	}
+
+	assert.NoError(t, l.Open())
+	return l
+}
+
+func TestLayer_Open(t *testing.T) {
+	l := newLayer(t, nil)
+	assert.Equal(t, "shared deps", l.Description)
+	assert.Equal(t, []string{"nodejs18.x"}, l.Runtimes)
+}
+
+func TestLayer_Deploy_unchanged(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+	l := newLayer(t, serviceMock)
+
+	zip, err := l.BuildBytes()
+	assert.NoError(t, err)
+
+	serviceMock.EXPECT().ListLayerVersions(gomock.Any()).Return(&lambda.ListLayerVersionsOutput{
+		LayerVersions: []*lambda.LayerVersionsListItem{{Version: aws.Int64(3)}},
+	}, nil)
+
+	serviceMock.EXPECT().GetLayerVersion(&lambda.GetLayerVersionInput{
+		LayerName:     aws.String("test_deps"),
+		VersionNumber: aws.Int64(3),
+	}).Return(&lambda.GetLayerVersionOutput{
+		LayerVersionArn: aws.String("arn:layer:test_deps:3"),
+		Content:         &lambda.LayerVersionContentOutput{CodeSha256: aws.String(utils.Sha256(zip))},
+	}, nil)
+
+	arn, err := l.Deploy()
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:layer:test_deps:3", arn)
+}
+
+func TestLayer_Deploy_publish(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+	l := newLayer(t, serviceMock)
+
+	serviceMock.EXPECT().ListLayerVersions(gomock.Any()).Return(nil, awserr.New("ResourceNotFoundException", "not found", nil))
+	serviceMock.EXPECT().PublishLayerVersion(gomock.Any()).Return(&lambda.PublishLayerVersionOutput{
+		LayerVersionArn: aws.String("arn:layer:test_deps:1"),
+		Version:         aws.Int64(1),
+	}, nil)
+
+	arn, err := l.Deploy()
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:layer:test_deps:1", arn)
+}
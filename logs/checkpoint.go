@@ -0,0 +1,192 @@
+package logs
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Lookback is the window re-queried before the position of a log group
+// when resuming, as CloudWatch may ingest events late with earlier timestamps.
+const Lookback = 5 * time.Minute
+
+// lookback in milliseconds.
+const lookback = int64(Lookback / time.Millisecond)
+
+// Position of a log group, the timestamp in milliseconds of its last
+// event, and the timestamps of the events within Lookback of it by ID,
+// as several events may share the same millisecond or arrive late.
+type Position struct {
+	Timestamp int64            `json:"timestamp"`
+	Events    map[string]int64 `json:"events"`
+	pruned    int64
+}
+
+// add the event `id` at `timestamp`, moving the position forward. Events
+// outside of the lookback window are pruned once it has moved past them.
+func (p *Position) add(timestamp int64, id string) {
+	if timestamp > p.Timestamp {
+		p.Timestamp = timestamp
+	}
+
+	if timestamp < p.Timestamp-lookback {
+		return
+	}
+
+	if p.Events == nil {
+		p.Events = make(map[string]int64)
+	}
+
+	p.Events[id] = timestamp
+
+	if p.Timestamp-p.pruned > lookback {
+		p.prune()
+	}
+}
+
+// prune the events outside of the lookback window.
+func (p *Position) prune() {
+	for id, timestamp := range p.Events {
+		if timestamp < p.Timestamp-lookback {
+			delete(p.Events, id)
+		}
+	}
+
+	p.pruned = p.Timestamp
+}
+
+// seen returns the IDs of the events within the lookback window.
+func (p *Position) seen() map[string]bool {
+	seen := make(map[string]bool)
+	for id := range p.Events {
+		seen[id] = true
+	}
+
+	return seen
+}
+
+// copy returns a copy of the position.
+func (p *Position) copy() *Position {
+	c := &Position{
+		Timestamp: p.Timestamp,
+		Events:    make(map[string]int64),
+		pruned:    p.pruned,
+	}
+
+	for id, timestamp := range p.Events {
+		c.Events[id] = timestamp
+	}
+
+	return c
+}
+
+// Checkpoint records the position of each log group, and the size of
+// the file events are written to, so that exports may be resumed.
+type Checkpoint struct {
+	mu sync.Mutex
+
+	// Groups positions by group key.
+	Groups map[string]*Position `json:"groups"`
+
+	// Offset is the size of the export file at the time of the checkpoint.
+	Offset int64 `json:"offset"`
+}
+
+// LoadCheckpoint returns the checkpoint stored at `path`,
+// or an empty checkpoint when the file does not exist.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	c := &Checkpoint{Groups: make(map[string]*Position)}
+
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, err
+	}
+
+	if c.Groups == nil {
+		c.Groups = make(map[string]*Position)
+	}
+
+	return c, nil
+}
+
+// Save the checkpoint to `path`, replacing it atomically.
+func (c *Checkpoint) Save(path string) error {
+	c.mu.Lock()
+	for _, pos := range c.Groups {
+		pos.prune()
+	}
+	b, err := json.MarshalIndent(c, "", "  ")
+	c.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Add event `e`, moving the position of its group forward.
+func (c *Checkpoint) Add(e *Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := groupKey(e.GroupName, e.Region)
+
+	pos, ok := c.Groups[key]
+	if !ok {
+		pos = &Position{}
+		c.Groups[key] = pos
+	}
+
+	pos.add(e.Timestamp.UnixNano()/int64(time.Millisecond), e.ID)
+}
+
+// Position returns a copy of the position of `group`, or nil.
+func (c *Checkpoint) Position(group Group) *Position {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pos, ok := c.Groups[groupKey(group.Name, group.Region)]
+	if !ok {
+		return nil
+	}
+
+	return pos.copy()
+}
+
+// groupKey returns the key of group `name` in `region`, prefixed
+// with the region only for functions in several regions.
+func groupKey(name, region string) string {
+	if region == "" {
+		return name
+	}
+
+	return region + ":" + name
+}
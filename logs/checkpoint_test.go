@@ -0,0 +1,38 @@
+package logs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apex-checkpoint")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "logs.checkpoint")
+
+	c, err := LoadCheckpoint(path)
+	assert.NoError(t, err, "load missing")
+	assert.Nil(t, c.Position(Group{Name: "/aws/lambda/app_api"}))
+
+	ts := time.Unix(2, 0)
+	c.Add(&Event{ID: "a", GroupName: "/aws/lambda/app_api", Timestamp: ts})
+	c.Add(&Event{ID: "b", GroupName: "/aws/lambda/app_api", Timestamp: ts})
+	c.Add(&Event{ID: "c", GroupName: "/aws/lambda/app_api", Region: "eu-west-1", Timestamp: ts.Add(time.Second)})
+	c.Offset = 120
+	assert.NoError(t, c.Save(path), "save")
+
+	c, err = LoadCheckpoint(path)
+	assert.NoError(t, err, "load")
+	assert.Equal(t, int64(120), c.Offset)
+	assert.Equal(t, map[string]int64{"a": 2000, "b": 2000}, c.Position(Group{Name: "/aws/lambda/app_api"}).Events)
+	assert.Equal(t, int64(2000), c.Position(Group{Name: "/aws/lambda/app_api"}).Timestamp)
+	assert.Equal(t, map[string]int64{"c": 3000}, c.Position(Group{Name: "/aws/lambda/app_api", Region: "eu-west-1"}).Events)
+	assert.Equal(t, int64(3000), c.Position(Group{Name: "/aws/lambda/app_api", Region: "eu-west-1"}).Timestamp)
+}
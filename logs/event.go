@@ -0,0 +1,177 @@
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Levels in increasing order of severity.
+var Levels = []string{"trace", "debug", "info", "warn", "error", "fatal"}
+
+// ParseLevel returns the normalized level `s`, such as "warn" for "WARNING".
+func ParseLevel(s string) (string, error) {
+	level := normalizeLevel(s)
+
+	if severity(level) == -1 {
+		return "", fmt.Errorf("unknown level %q, must be one of %s", s, strings.Join(Levels, ", "))
+	}
+
+	return level, nil
+}
+
+// normalizeLevel returns the lowercase level `s` with common aliases resolved.
+func normalizeLevel(s string) string {
+	switch level := strings.ToLower(strings.TrimSpace(s)); level {
+	case "warning":
+		return "warn"
+	case "err":
+		return "error"
+	case "critical":
+		return "fatal"
+	default:
+		return level
+	}
+}
+
+// severity returns the index of `level` in Levels, or -1.
+func severity(level string) int {
+	for i, l := range Levels {
+		if l == level {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// platform prefixes of the lines logged by Lambda for each invocation.
+var platform = []string{"START", "END", "REPORT", "INIT_REPORT"}
+
+// parse the request ID, level and fields of the event's message.
+// Messages logged by Lambda, such as "START RequestId: <id>", the
+// tab separated lines of the runtimes, such as "<time>\t<id>\tINFO\t<text>",
+// and JSON objects are supported. Text is the message without the prefix.
+func (e *Event) parse() {
+	msg := strings.TrimRight(e.Message, "\n")
+	e.Text = msg
+
+	for _, prefix := range platform {
+		if strings.HasPrefix(msg, prefix+" RequestId: ") {
+			if fields := strings.Fields(strings.TrimPrefix(msg, prefix+" RequestId: ")); len(fields) > 0 {
+				e.RequestID = fields[0]
+			}
+			return
+		}
+	}
+
+	// Lambda: <time> <id> Task timed out after 3.00 seconds
+	if parts := strings.SplitN(msg, " ", 3); len(parts) == 3 && isTime(parts[0]) && strings.HasPrefix(parts[2], "Task timed out") {
+		e.RequestID = parts[1]
+		e.Text = parts[2]
+		return
+	}
+
+	if parts := strings.SplitN(msg, "\t", 4); len(parts) == 4 {
+		// Node.js: <time>\t<id>\t<level>\t<text>
+		if isTime(parts[0]) && severity(normalizeLevel(parts[2])) != -1 {
+			e.RequestID = parts[1]
+			e.Level = normalizeLevel(parts[2])
+			e.Text = parts[3]
+		}
+
+		// Python: [<level>]\t<time>\t<id>\t<text>
+		if level := strings.Trim(parts[0], "[]"); isTime(parts[1]) && severity(normalizeLevel(level)) != -1 {
+			e.RequestID = parts[2]
+			e.Level = normalizeLevel(level)
+			e.Text = parts[3]
+		}
+	}
+
+	if !strings.HasPrefix(e.Text, "{") {
+		return
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(e.Text), &fields); err != nil {
+		return
+	}
+
+	e.Fields = fields
+
+	if e.Level == "" {
+		for _, key := range []string{"level", "severity", "lvl"} {
+			if s, ok := fields[key].(string); ok {
+				e.Level = normalizeLevel(s)
+				break
+			}
+		}
+	}
+
+	if e.RequestID == "" {
+		for _, key := range []string{"requestId", "request_id", "AWSRequestId", "record.requestId"} {
+			if s, ok := e.Field(key).(string); ok {
+				e.RequestID = s
+				break
+			}
+		}
+	}
+}
+
+// isTime returns true if `s` is an RFC3339 timestamp.
+func isTime(s string) bool {
+	_, err := time.Parse(time.RFC3339Nano, s)
+	return err == nil
+}
+
+// Field returns the value of the JSON field `name`, using dots for nested
+// fields such as "user.id", or nil when the field is not present.
+func (e *Event) Field(name string) interface{} {
+	if v, ok := e.Fields[name]; ok {
+		return v
+	}
+
+	var v interface{} = e.Fields
+	for _, key := range strings.Split(name, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		v = m[key]
+	}
+
+	return v
+}
+
+// HasLevel returns true if the event's level is at least as severe as `level`.
+// Events without a level, such as the lines logged by Lambda, never match.
+func (e *Event) HasLevel(level string) bool {
+	return e.Level != "" && severity(e.Level) >= severity(level)
+}
+
+// Matches returns true if the JSON field `name` is present with `value`.
+// Numbers and booleans are compared by their JSON text.
+func (e *Event) Matches(name, value string) bool {
+	v := e.Field(name)
+	return v != nil && FormatValue(v) == value
+}
+
+// FormatValue returns the text of the JSON value `v`, strings unquoted.
+func FormatValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return "null"
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
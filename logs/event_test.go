@@ -0,0 +1,88 @@
+package logs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvent_parse(t *testing.T) {
+	t.Run("platform", func(t *testing.T) {
+		e := &Event{Message: "REPORT RequestId: 8f5a\tDuration: 1.52 ms\tBilled Duration: 2 ms\n"}
+		e.parse()
+		assert.Equal(t, "8f5a", e.RequestID)
+		assert.Equal(t, "", e.Level)
+		assert.Nil(t, e.Fields)
+	})
+
+	t.Run("nodejs", func(t *testing.T) {
+		e := &Event{Message: "2024-03-01T10:00:00.000Z\t8f5a\tERROR\tboom\n"}
+		e.parse()
+		assert.Equal(t, "8f5a", e.RequestID)
+		assert.Equal(t, "error", e.Level)
+		assert.Equal(t, "boom", e.Text)
+	})
+
+	t.Run("python", func(t *testing.T) {
+		e := &Event{Message: "[WARNING]\t2024-03-01T10:00:00.000Z\t8f5a\tslow\n"}
+		e.parse()
+		assert.Equal(t, "8f5a", e.RequestID)
+		assert.Equal(t, "warn", e.Level)
+		assert.Equal(t, "slow", e.Text)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		e := &Event{Message: `{"level":"INFO","message":"login","requestId":"8f5a","user":{"id":42}}` + "\n"}
+		e.parse()
+		assert.Equal(t, "8f5a", e.RequestID)
+		assert.Equal(t, "info", e.Level)
+		assert.Equal(t, "login", e.Field("message"))
+		assert.True(t, e.Matches("user.id", "42"))
+		assert.False(t, e.Matches("user.id", "4"))
+		assert.False(t, e.Matches("user.name", "42"))
+	})
+
+	t.Run("nodejs json", func(t *testing.T) {
+		e := &Event{Message: "2024-03-01T10:00:00.000Z\t8f5a\tINFO\t{\"user_id\":\"42\"}\n"}
+		e.parse()
+		assert.Equal(t, "info", e.Level)
+		assert.True(t, e.Matches("user_id", "42"))
+	})
+
+	t.Run("text", func(t *testing.T) {
+		e := &Event{Message: "hello {world}\n"}
+		e.parse()
+		assert.Equal(t, "hello {world}", e.Text)
+		assert.Equal(t, "", e.RequestID)
+		assert.Nil(t, e.Fields)
+	})
+}
+
+func TestFilter_Match(t *testing.T) {
+	f := Filter{Level: "warn", Where: map[string]string{"user_id": "42"}}
+
+	e := &Event{Message: `{"level":"error","user_id":42}`}
+	e.parse()
+	assert.True(t, f.Match(e))
+
+	e = &Event{Message: `{"level":"info","user_id":42}`}
+	e.parse()
+	assert.False(t, f.Match(e))
+
+	e = &Event{Message: `{"level":"error","user_id":7}`}
+	e.parse()
+	assert.False(t, f.Match(e))
+
+	e = &Event{Message: "END RequestId: 8f5a"}
+	e.parse()
+	assert.False(t, f.Match(e))
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := ParseLevel("WARNING")
+	assert.NoError(t, err)
+	assert.Equal(t, "warn", level)
+
+	_, err = ParseLevel("loud")
+	assert.EqualError(t, err, `unknown level "loud", must be one of trace, debug, info, warn, error, fatal`)
+}
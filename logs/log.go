@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 )
@@ -14,6 +15,9 @@ import (
 type Log struct {
 	Config
 	GroupName string
+	Function  string
+	Region    string
+	Position  *Position
 	Log       log.Interface
 	err       error
 }
@@ -32,60 +36,100 @@ func (l *Log) start(ch chan<- *Event) {
 	l.Log.Debug("enter")
 	defer l.Log.Debug("exit")
 
-	var start = l.StartTime.UnixNano() / int64(time.Millisecond)
+	pos := &Position{Timestamp: l.StartTime.UnixNano() / int64(time.Millisecond)}
+	start := pos.Timestamp
+
+	// a position is resumed from whatever the start time, re-querying
+	// the lookback window for events ingested late
+	if l.Position != nil {
+		pos = l.Position.copy()
+		start = pos.Timestamp - lookback
+	}
+
 	var nextToken *string
 	var err error
 
+	// the start time is only moved once all pages are fetched,
+	// the events already seen are skipped as it's inclusive
+	seen := pos.seen()
+
 	for {
 		l.Log.WithField("start", start).Debug("request")
-		nextToken, start, err = l.fetch(nextToken, start, ch)
+		nextToken, err = l.fetch(nextToken, start, seen, pos, ch)
 
 		if err != nil {
 			l.err = fmt.Errorf("log %q: %s", l.GroupName, err)
 			break
 		}
 
-		if nextToken == nil && l.Follow {
-			time.Sleep(l.PollInterval)
-			l.Log.WithField("start", start).Debug("poll")
+		if nextToken != nil {
 			continue
 		}
 
-		if nextToken == nil {
+		if !l.Follow {
 			break
 		}
+
+		start = pos.Timestamp
+		seen = pos.seen()
+		time.Sleep(l.PollInterval)
+		l.Log.WithField("start", start).Debug("poll")
 	}
 }
 
-// fetch logs relative to the given token and start time. We ignore when the log group is not found.
-func (l *Log) fetch(nextToken *string, start int64, ch chan<- *Event) (*string, int64, error) {
-	res, err := l.Service.FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+// fetch logs relative to the given token and start time, skipping the `seen` events
+// and updating the position `pos`. We ignore when the log group is not found.
+func (l *Log) fetch(nextToken *string, start int64, seen map[string]bool, pos *Position, ch chan<- *Event) (*string, error) {
+	input := &cloudwatchlogs.FilterLogEventsInput{
 		LogGroupName:  &l.GroupName,
 		FilterPattern: &l.FilterPattern,
 		StartTime:     &start,
 		NextToken:     nextToken,
-	})
+	}
+
+	if !l.EndTime.IsZero() {
+		input.EndTime = aws.Int64(l.EndTime.UnixNano() / int64(time.Millisecond))
+	}
+
+	res, err := l.Service.FilterLogEvents(input)
 
 	if e, ok := err.(awserr.Error); ok {
 		if e.Code() == "ResourceNotFoundException" {
 			l.Log.Debug("not found")
-			return nil, 0, nil
+			return nil, nil
 		}
 	}
 
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 
 	for _, event := range res.Events {
-		start = *event.Timestamp + 1
-		ch <- &Event{
-			GroupName: l.GroupName,
-			Message:   *event.Message,
+		id := aws.StringValue(event.EventId)
+		if seen[id] {
+			continue
+		}
+
+		pos.add(*event.Timestamp, id)
+
+		e := &Event{
+			ID:         id,
+			GroupName:  l.GroupName,
+			Function:   l.Function,
+			Region:     l.Region,
+			StreamName: aws.StringValue(event.LogStreamName),
+			Timestamp:  time.Unix(0, *event.Timestamp*int64(time.Millisecond)).UTC(),
+			Message:    *event.Message,
+		}
+
+		e.parse()
+
+		if l.Match(e) {
+			ch <- e
 		}
 	}
 
-	return res.NextToken, start, nil
+	return res.NextToken, nil
 }
 
 // Err returns the first error, if any, during processing.
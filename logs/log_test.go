@@ -0,0 +1,140 @@
+package logs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/stretchr/testify/assert"
+)
+
+// filterService returns the `events` at or after the start time of each request.
+type filterService struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	events []*cloudwatchlogs.FilteredLogEvent
+	starts []int64
+}
+
+func (s *filterService) FilterLogEvents(in *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+	s.starts = append(s.starts, *in.StartTime)
+
+	var events []*cloudwatchlogs.FilteredLogEvent
+	for _, e := range s.events {
+		if *e.Timestamp >= *in.StartTime && (in.EndTime == nil || *e.Timestamp <= *in.EndTime) {
+			events = append(events, e)
+		}
+	}
+
+	return &cloudwatchlogs.FilterLogEventsOutput{Events: events}, nil
+}
+
+func logEvent(id string, ts int64) *cloudwatchlogs.FilteredLogEvent {
+	return &cloudwatchlogs.FilteredLogEvent{
+		EventId:       aws.String(id),
+		Timestamp:     aws.Int64(ts),
+		LogStreamName: aws.String("stream"),
+		Message:       aws.String("message " + id + "\n"),
+	}
+}
+
+func ids(ch <-chan *Event) (ids []string) {
+	for e := range ch {
+		ids = append(ids, e.ID)
+	}
+	return
+}
+
+func TestLog_position(t *testing.T) {
+	s := &filterService{
+		events: []*cloudwatchlogs.FilteredLogEvent{
+			logEvent("a", 1000),
+			logEvent("b", 2000),
+			logEvent("c", 2000),
+			logEvent("d", 3000),
+		},
+	}
+
+	t.Run("start", func(t *testing.T) {
+		l := Log{
+			Config:    Config{Service: s, StartTime: time.Unix(2, 0)},
+			GroupName: "/aws/lambda/app_api",
+			Log:       log.Log,
+		}
+
+		assert.Equal(t, []string{"b", "c", "d"}, ids(l.Start()))
+		assert.NoError(t, l.Err())
+	})
+
+	t.Run("end", func(t *testing.T) {
+		l := Log{
+			Config:    Config{Service: s, StartTime: time.Unix(0, 0), EndTime: time.Unix(2, 0)},
+			GroupName: "/aws/lambda/app_api",
+			Log:       log.Log,
+		}
+
+		assert.Equal(t, []string{"a", "b", "c"}, ids(l.Start()))
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		l := Log{
+			Config:    Config{Service: s, StartTime: time.Unix(0, 0)},
+			GroupName: "/aws/lambda/app_api",
+			Position:  &Position{Timestamp: 2000, Events: map[string]int64{"a": 1000, "b": 2000}},
+			Log:       log.Log,
+		}
+
+		assert.Equal(t, []string{"c", "d"}, ids(l.Start()))
+	})
+
+	t.Run("resume after start time", func(t *testing.T) {
+		l := Log{
+			Config:    Config{Service: s, StartTime: time.Unix(600, 0)},
+			GroupName: "/aws/lambda/app_api",
+			Position:  &Position{Timestamp: 2000, Events: map[string]int64{"a": 1000, "b": 2000}},
+			Log:       log.Log,
+		}
+
+		assert.Equal(t, []string{"c", "d"}, ids(l.Start()))
+	})
+}
+
+func TestLog_lateEvents(t *testing.T) {
+	s := &filterService{
+		events: []*cloudwatchlogs.FilteredLogEvent{
+			logEvent("a", 100000),
+			logEvent("late", 150000),
+			logEvent("b", 200000),
+			logEvent("c", 200000),
+		},
+	}
+
+	l := Log{
+		Config:    Config{Service: s},
+		GroupName: "/aws/lambda/app_api",
+		Position:  &Position{Timestamp: 200000, Events: map[string]int64{"a": 100000, "b": 200000, "c": 200000}},
+		Log:       log.Log,
+	}
+
+	assert.Equal(t, []string{"late"}, ids(l.Start()))
+	assert.Equal(t, []int64{200000 - int64(Lookback/time.Millisecond)}, s.starts)
+}
+
+func TestPosition_add(t *testing.T) {
+	var p Position
+	p.add(1000, "a")
+	p.add(2000, "b")
+	p.add(2000, "c")
+	p.add(1500, "late")
+	assert.Equal(t, int64(2000), p.Timestamp)
+	assert.Equal(t, map[string]int64{"a": 1000, "b": 2000, "c": 2000, "late": 1500}, p.Events)
+
+	// events outside of the lookback window are pruned
+	far := 2000 + int64(2*Lookback/time.Millisecond)
+	p.add(far, "d")
+	p.add(1000, "too late")
+	assert.Equal(t, far, p.Timestamp)
+	assert.Equal(t, map[string]int64{"d": far}, p.Events)
+}
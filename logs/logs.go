@@ -10,8 +10,25 @@ import (
 
 // Event is a single log event from a group.
 type Event struct {
-	GroupName string
-	Message   string
+	ID         string
+	GroupName  string
+	Function   string
+	Region     string
+	StreamName string
+	Timestamp  time.Time
+	Message    string
+
+	// RequestID of the invocation which logged the event, when known.
+	RequestID string
+
+	// Level of the event, such as "error", when known.
+	Level string
+
+	// Text is the message without the prefix added by the runtime.
+	Text string
+
+	// Fields of messages which are JSON objects.
+	Fields map[string]interface{}
 }
 
 // Config is used to configure Logs and Log.
@@ -20,14 +37,56 @@ type Config struct {
 	FilterPattern string
 	PollInterval  time.Duration
 	StartTime     time.Time
+	EndTime       time.Time
 	Follow        bool
+	Filter
+}
+
+// Filter of events by level and JSON fields.
+type Filter struct {
+	// Level is the minimum level of events, all events when empty.
+	Level string
+
+	// Where filters events by JSON fields, see Event.Matches.
+	Where map[string]string
+}
+
+// Match returns true if the event `e` passes the level and field filters.
+func (f *Filter) Match(e *Event) bool {
+	if f.Level != "" && !e.HasLevel(f.Level) {
+		return false
+	}
+
+	for name, value := range f.Where {
+		if !e.Matches(name, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Empty returns true if the filter matches all events.
+func (f *Filter) Empty() bool {
+	return f.Level == "" && len(f.Where) == 0
+}
+
+// Group is a log group, fetched with its own service when
+// in a region other than the one of the configured service,
+// and resumed from its position when present.
+type Group struct {
+	Name     string
+	Function string
+	Region   string
+	Service  cloudwatchlogsiface.CloudWatchLogsAPI
+	Position *Position
 }
 
 // Logs fetches or tails logs from CloudWatchLogs for any number of groups.
 type Logs struct {
 	Config
-	GroupNames []string
-	err        error
+	Groups []Group
+	err    error
 }
 
 // Start consuming logs.
@@ -35,8 +94,8 @@ func (l *Logs) Start() <-chan *Event {
 	ch := make(chan *Event)
 	done := make(chan error)
 
-	for _, name := range l.GroupNames {
-		go l.consume(name, ch, done)
+	for _, group := range l.Groups {
+		go l.consume(group, ch, done)
 	}
 
 	go func() {
@@ -54,7 +113,7 @@ func (l *Logs) Err() error {
 
 // wait for each log group to complete.
 func (l *Logs) wait(done <-chan error) {
-	for range l.GroupNames {
+	for range l.Groups {
 		if err := <-done; err != nil {
 			l.err = err
 			return
@@ -62,12 +121,20 @@ func (l *Logs) wait(done <-chan error) {
 	}
 }
 
-// consume logs for group `name`.
-func (l *Logs) consume(name string, ch chan *Event, done chan error) {
+// consume logs for `group`.
+func (l *Logs) consume(group Group, ch chan *Event, done chan error) {
+	config := l.Config
+	if group.Service != nil {
+		config.Service = group.Service
+	}
+
 	log := Log{
-		Config:    l.Config,
-		GroupName: name,
-		Log:       log.WithField("group", name),
+		Config:    config,
+		GroupName: group.Name,
+		Function:  group.Function,
+		Region:    group.Region,
+		Position:  group.Position,
+		Log:       log.WithField("group", group.Name),
 	}
 
 	for event := range log.Start() {
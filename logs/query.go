@@ -0,0 +1,173 @@
+package logs
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+)
+
+// Queries are the built-in Logs Insights queries by name.
+var Queries = map[string]string{
+	"slowest": `filter @type = "REPORT"
+| fields @timestamp, @requestId, @duration, @billedDuration, @maxMemoryUsed / 1000000 as maxMemoryUsedMB, @log
+| sort @duration desc
+| limit 25`,
+
+	"errors": `filter @message like /(?i)(error|exception)/ and @type != "REPORT"
+| stats count(*) as count by @message
+| sort count desc
+| limit 25`,
+
+	"cold-starts": `filter @type = "REPORT" and ispresent(@initDuration)
+| stats count(*) as coldStarts, avg(@initDuration) as avgInitDuration, max(@initDuration) as maxInitDuration by bin(1h) as hour
+| sort hour asc`,
+}
+
+// QueryNames returns the names of the built-in queries.
+func QueryNames() []string {
+	var names []string
+	for name := range Queries {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// maxQueryGroups is the maximum number of log groups of a query.
+const maxQueryGroups = 50
+
+// Query is a Logs Insights query over log groups of a single region.
+type Query struct {
+	Service      cloudwatchlogsiface.CloudWatchLogsAPI
+	Groups       []string
+	Query        string
+	StartTime    time.Time
+	EndTime      time.Time
+	Limit        int64
+	PollInterval time.Duration
+}
+
+// Row is a single result of a query, with its fields in order.
+type Row []Field
+
+// Field of a result.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Get returns the value of field `name`.
+func (r Row) Get(name string) string {
+	for _, f := range r {
+		if f.Name == name {
+			return f.Value
+		}
+	}
+
+	return ""
+}
+
+// Run the query, waiting for its completion, and return its rows.
+// Internal fields such as @ptr are omitted.
+func (q *Query) Run() ([]Row, error) {
+	if len(q.Groups) > maxQueryGroups {
+		return nil, fmt.Errorf("queries cover at most %d log groups, got %d", maxQueryGroups, len(q.Groups))
+	}
+
+	interval := q.PollInterval
+	if interval == 0 {
+		interval = time.Second
+	}
+
+	input := &cloudwatchlogs.StartQueryInput{
+		LogGroupNames: aws.StringSlice(q.Groups),
+		QueryString:   &q.Query,
+		StartTime:     aws.Int64(q.StartTime.Unix()),
+		EndTime:       aws.Int64(q.EndTime.Unix()),
+	}
+
+	if q.Limit > 0 {
+		input.Limit = &q.Limit
+	}
+
+	res, err := q.Service.StartQuery(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		out, err := q.Service.GetQueryResults(&cloudwatchlogs.GetQueryResultsInput{
+			QueryId: res.QueryId,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch status := aws.StringValue(out.Status); status {
+		case cloudwatchlogs.QueryStatusComplete:
+			return rows(out.Results), nil
+		case cloudwatchlogs.QueryStatusScheduled, cloudwatchlogs.QueryStatusRunning:
+			time.Sleep(interval)
+		default:
+			return nil, fmt.Errorf("query %s: %s", aws.StringValue(res.QueryId), strings.ToLower(status))
+		}
+	}
+}
+
+// rows returns the results without internal fields.
+func rows(results [][]*cloudwatchlogs.ResultField) []Row {
+	var list []Row
+
+	for _, result := range results {
+		var row Row
+
+		for _, f := range result {
+			name := aws.StringValue(f.Field)
+			if name == "@ptr" {
+				continue
+			}
+
+			row = append(row, Field{
+				Name:  name,
+				Value: aws.StringValue(f.Value),
+			})
+		}
+
+		list = append(list, row)
+	}
+
+	return list
+}
+
+// Columns returns the field names of `rows` in order of appearance.
+func Columns(rows []Row) []string {
+	var names []string
+
+	for _, row := range rows {
+		for _, f := range row {
+			if !containsString(names, f.Name) {
+				names = append(names, f.Name)
+			}
+		}
+	}
+
+	return names
+}
+
+// containsString returns true if `s` is in `list`.
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+package logs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
+	"github.com/stretchr/testify/assert"
+)
+
+// queryService responds to queries with `statuses` in order.
+type queryService struct {
+	cloudwatchlogsiface.CloudWatchLogsAPI
+	input    *cloudwatchlogs.StartQueryInput
+	statuses []string
+	results  [][]*cloudwatchlogs.ResultField
+}
+
+func (s *queryService) StartQuery(in *cloudwatchlogs.StartQueryInput) (*cloudwatchlogs.StartQueryOutput, error) {
+	s.input = in
+	return &cloudwatchlogs.StartQueryOutput{QueryId: aws.String("q1")}, nil
+}
+
+func (s *queryService) GetQueryResults(in *cloudwatchlogs.GetQueryResultsInput) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	if len(s.statuses) == 0 {
+		return nil, errors.New("no more results")
+	}
+
+	status := s.statuses[0]
+	s.statuses = s.statuses[1:]
+
+	return &cloudwatchlogs.GetQueryResultsOutput{
+		Status:  aws.String(status),
+		Results: s.results,
+	}, nil
+}
+
+func field(name, value string) *cloudwatchlogs.ResultField {
+	return &cloudwatchlogs.ResultField{Field: aws.String(name), Value: aws.String(value)}
+}
+
+func TestQuery_Run(t *testing.T) {
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("complete", func(t *testing.T) {
+		s := &queryService{
+			statuses: []string{"Scheduled", "Running", "Complete"},
+			results: [][]*cloudwatchlogs.ResultField{
+				{field("@message", "boom"), field("count", "3"), field("@ptr", "x")},
+				{field("@message", "oops"), field("count", "1"), field("extra", "y")},
+			},
+		}
+
+		q := Query{
+			Service:      s,
+			Groups:       []string{"/aws/lambda/app_api"},
+			Query:        Queries["errors"],
+			StartTime:    start,
+			EndTime:      start.Add(time.Hour),
+			PollInterval: time.Millisecond,
+		}
+
+		rows, err := q.Run()
+		assert.NoError(t, err)
+		assert.Len(t, rows, 2)
+		assert.Equal(t, "3", rows[0].Get("count"))
+		assert.Equal(t, "", rows[0].Get("@ptr"))
+		assert.Equal(t, []string{"@message", "count", "extra"}, Columns(rows))
+		assert.Equal(t, start.Unix(), *s.input.StartTime)
+		assert.Equal(t, start.Add(time.Hour).Unix(), *s.input.EndTime)
+		assert.Nil(t, s.input.Limit)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		q := Query{
+			Service:      &queryService{statuses: []string{"Running", "Failed"}},
+			Groups:       []string{"/aws/lambda/app_api"},
+			Query:        "fields @message",
+			PollInterval: time.Millisecond,
+		}
+
+		_, err := q.Run()
+		assert.EqualError(t, err, "query q1: failed")
+	})
+}
@@ -0,0 +1,280 @@
+package logs
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Invocation statuses.
+const (
+	StatusSuccess    = "success"
+	StatusError      = "error"
+	StatusTimeout    = "timeout"
+	StatusIncomplete = "incomplete"
+)
+
+// maxInvocation is how long after its last event an invocation without a
+// REPORT line is considered incomplete, longer than the maximum timeout.
+const maxInvocation = 16 * time.Minute
+
+// Invocation is a single invocation of a function assembled
+// from the events logged with its request ID.
+type Invocation struct {
+	GroupName string
+	Function  string
+	Region    string
+	RequestID string
+	Timestamp time.Time
+	Status    string
+
+	// Duration and BilledDuration of the invocation from its REPORT line.
+	Duration       time.Duration
+	BilledDuration time.Duration
+
+	// InitDuration of the execution environment, only for cold starts.
+	InitDuration time.Duration
+
+	// MemorySize and MaxMemoryUsed in MB.
+	MemorySize    int
+	MaxMemoryUsed int
+
+	// Events logged by the invocation, except the lines logged by Lambda.
+	Events []*Event
+}
+
+// Failed returns true if the invocation errored or timed out.
+func (i *Invocation) Failed() bool {
+	return i.Status == StatusError || i.Status == StatusTimeout
+}
+
+// ColdStart returns true if the invocation initialized its execution environment.
+func (i *Invocation) ColdStart() bool {
+	return i.InitDuration > 0
+}
+
+// Requests groups log events by request ID, emitting an Invocation once
+// its REPORT line is logged. Lines logged without a request ID are assigned
+// to the invocation running in the same log stream, or to the next one.
+type Requests struct {
+	// Failed only emits invocations which errored or timed out.
+	Failed bool
+
+	// Slow only emits invocations lasting at least the duration, when non-zero.
+	// Failed or slow invocations are emitted when both are set.
+	Slow time.Duration
+
+	// Filter only emits invocations with at least one matching event.
+	Filter
+
+	pending map[string]*Invocation // by group and request ID
+	running map[string]*Invocation // by group and stream
+	orphans map[string][]*Event    // by group and stream
+}
+
+// Start consuming `events`, the returned channel is closed after `events`,
+// emitting the invocations without REPORT lines as incomplete.
+func (r *Requests) Start(events <-chan *Event) <-chan *Invocation {
+	ch := make(chan *Invocation)
+
+	r.pending = make(map[string]*Invocation)
+	r.running = make(map[string]*Invocation)
+	r.orphans = make(map[string][]*Event)
+
+	go func() {
+		defer close(ch)
+
+		for e := range events {
+			r.add(e, ch)
+		}
+
+		for _, inv := range r.sorted() {
+			inv.Status = StatusIncomplete
+			r.emit(inv, ch)
+		}
+	}()
+
+	return ch
+}
+
+// add event `e` to its invocation, emitting it when reported.
+func (r *Requests) add(e *Event, ch chan<- *Invocation) {
+	stream := e.GroupName + "/" + e.Region + "/" + e.StreamName
+
+	if e.RequestID == "" {
+		if inv, ok := r.running[stream]; ok {
+			inv.Events = append(inv.Events, e)
+		} else {
+			r.orphans[stream] = append(r.orphans[stream], e)
+		}
+		return
+	}
+
+	key := e.GroupName + "/" + e.Region + "/" + e.RequestID
+
+	inv, ok := r.pending[key]
+	if !ok {
+		inv = &Invocation{
+			GroupName: e.GroupName,
+			Function:  e.Function,
+			Region:    e.Region,
+			RequestID: e.RequestID,
+			Timestamp: e.Timestamp,
+		}
+
+		inv.Events = append(inv.Events, r.orphans[stream]...)
+		delete(r.orphans, stream)
+		r.pending[key] = inv
+	}
+
+	switch {
+	case strings.HasPrefix(e.Text, "START RequestId: "):
+		r.running[stream] = inv
+	case strings.HasPrefix(e.Text, "END RequestId: "):
+		delete(r.running, stream)
+	case strings.HasPrefix(e.Text, "REPORT RequestId: "):
+		delete(r.running, stream)
+		delete(r.pending, key)
+		inv.report(e.Text)
+		r.emit(inv, ch)
+		r.expire(e.Timestamp, ch)
+	default:
+		inv.Events = append(inv.Events, e)
+		if strings.HasPrefix(e.Text, "Task timed out after") {
+			inv.Status = StatusTimeout
+		}
+	}
+}
+
+// report sets the metrics and status of the invocation from its REPORT line, such as
+// "REPORT RequestId: <id>\tDuration: 1.52 ms\tBilled Duration: 2 ms\tMemory Size: 128 MB".
+func (i *Invocation) report(line string) {
+	for _, field := range strings.Split(line, "\t") {
+		parts := strings.SplitN(field, ": ", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		value := strings.TrimSpace(parts[1])
+
+		switch strings.TrimSpace(parts[0]) {
+		case "Duration":
+			i.Duration = parseMillis(value)
+		case "Billed Duration":
+			i.BilledDuration = parseMillis(value)
+		case "Init Duration":
+			i.InitDuration = parseMillis(value)
+		case "Memory Size":
+			i.MemorySize = parseMegabytes(value)
+		case "Max Memory Used":
+			i.MaxMemoryUsed = parseMegabytes(value)
+		case "Status":
+			if value == StatusTimeout {
+				i.Status = StatusTimeout
+			} else if value == StatusError && i.Status == "" {
+				i.Status = StatusError
+			}
+		}
+	}
+
+	if i.Status != "" {
+		return
+	}
+
+	i.Status = StatusSuccess
+
+	for _, e := range i.Events {
+		if e.HasLevel("error") {
+			i.Status = StatusError
+		}
+	}
+}
+
+// expire emits the pending invocations without events since `maxInvocation` before `now`.
+func (r *Requests) expire(now time.Time, ch chan<- *Invocation) {
+	for _, inv := range r.sorted() {
+		last := inv.Timestamp
+		if n := len(inv.Events); n > 0 {
+			last = inv.Events[n-1].Timestamp
+		}
+
+		if now.Sub(last) < maxInvocation {
+			continue
+		}
+
+		for stream, running := range r.running {
+			if running == inv {
+				delete(r.running, stream)
+			}
+		}
+
+		delete(r.pending, inv.GroupName+"/"+inv.Region+"/"+inv.RequestID)
+		inv.Status = StatusIncomplete
+		r.emit(inv, ch)
+	}
+}
+
+// sorted returns the pending invocations by time.
+func (r *Requests) sorted() []*Invocation {
+	var list []*Invocation
+	for _, inv := range r.pending {
+		list = append(list, inv)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.Timestamp.Equal(b.Timestamp) {
+			return a.RequestID < b.RequestID
+		}
+		return a.Timestamp.Before(b.Timestamp)
+	})
+
+	return list
+}
+
+// emit the invocation `inv` when it passes the filters.
+func (r *Requests) emit(inv *Invocation, ch chan<- *Invocation) {
+	if r.match(inv) {
+		ch <- inv
+	}
+}
+
+// match returns true if the invocation `inv` passes the filters.
+func (r *Requests) match(inv *Invocation) bool {
+	if (r.Failed || r.Slow > 0) && !(r.Failed && inv.Failed()) && !(r.Slow > 0 && inv.Duration >= r.Slow) {
+		return false
+	}
+
+	if r.Filter.Empty() {
+		return true
+	}
+
+	for _, e := range inv.Events {
+		if r.Filter.Match(e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseMillis parses durations such as "1.52 ms".
+func parseMillis(s string) time.Duration {
+	ms, err := strconv.ParseFloat(strings.TrimSuffix(s, " ms"), 64)
+	if err != nil {
+		return 0
+	}
+
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+// parseMegabytes parses sizes such as "128 MB".
+func parseMegabytes(s string) int {
+	n, err := strconv.Atoi(strings.TrimSuffix(s, " MB"))
+	if err != nil {
+		return 0
+	}
+
+	return n
+}
@@ -0,0 +1,127 @@
+package logs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// invocations returns the invocations of `messages` logged in `stream`.
+func invocations(r *Requests, stream string, messages ...string) []*Invocation {
+	ch := make(chan *Event)
+
+	go func() {
+		start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+		for i, msg := range messages {
+			e := &Event{
+				GroupName:  "/aws/lambda/app_api",
+				Function:   "api",
+				StreamName: stream,
+				Timestamp:  start.Add(time.Duration(i) * time.Second),
+				Message:    msg + "\n",
+			}
+			e.parse()
+			ch <- e
+		}
+		close(ch)
+	}()
+
+	var list []*Invocation
+	for inv := range r.Start(ch) {
+		list = append(list, inv)
+	}
+
+	return list
+}
+
+func TestRequests(t *testing.T) {
+	t.Run("report", func(t *testing.T) {
+		list := invocations(&Requests{}, "s1",
+			"INIT_START Runtime Version: nodejs:18.v20",
+			"starting",
+			"START RequestId: a Version: $LATEST",
+			"2024-03-01T10:00:00.000Z\ta\tINFO\thello",
+			"END RequestId: a",
+			"REPORT RequestId: a\tDuration: 120.50 ms\tBilled Duration: 121 ms\tMemory Size: 128 MB\tMax Memory Used: 64 MB\tInit Duration: 150.00 ms\t",
+			"START RequestId: b Version: $LATEST",
+			"2024-03-01T10:00:00.000Z\tb\tERROR\tInvoke Error",
+			"END RequestId: b",
+			"REPORT RequestId: b\tDuration: 2.00 ms\tBilled Duration: 2 ms\tMemory Size: 128 MB\tMax Memory Used: 64 MB\t",
+			"START RequestId: c Version: $LATEST",
+		)
+
+		assert.Len(t, list, 3)
+
+		a := list[0]
+		assert.Equal(t, "a", a.RequestID)
+		assert.Equal(t, "api", a.Function)
+		assert.Equal(t, StatusSuccess, a.Status)
+		assert.Equal(t, 120500*time.Microsecond, a.Duration)
+		assert.Equal(t, 121*time.Millisecond, a.BilledDuration)
+		assert.Equal(t, 150*time.Millisecond, a.InitDuration)
+		assert.Equal(t, 128, a.MemorySize)
+		assert.Equal(t, 64, a.MaxMemoryUsed)
+		assert.True(t, a.ColdStart())
+		assert.Len(t, a.Events, 3)
+		assert.Equal(t, "starting", a.Events[1].Text)
+		assert.Equal(t, "hello", a.Events[2].Text)
+
+		b := list[1]
+		assert.Equal(t, StatusError, b.Status)
+		assert.False(t, b.ColdStart())
+		assert.True(t, b.Failed())
+
+		c := list[2]
+		assert.Equal(t, "c", c.RequestID)
+		assert.Equal(t, StatusIncomplete, c.Status)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		list := invocations(&Requests{}, "s1",
+			"START RequestId: a Version: $LATEST",
+			"2024-03-01T10:00:03.000Z a Task timed out after 3.00 seconds",
+			"END RequestId: a",
+			"REPORT RequestId: a\tDuration: 3000.00 ms\tBilled Duration: 3000 ms\tMemory Size: 128 MB\tMax Memory Used: 64 MB\t",
+		)
+
+		assert.Len(t, list, 1)
+		assert.Equal(t, StatusTimeout, list[0].Status)
+	})
+
+	t.Run("failed or slow", func(t *testing.T) {
+		messages := []string{
+			"START RequestId: a Version: $LATEST",
+			"REPORT RequestId: a\tDuration: 5.00 ms\tBilled Duration: 5 ms\tMemory Size: 128 MB\tMax Memory Used: 64 MB\t",
+			"START RequestId: b Version: $LATEST",
+			"REPORT RequestId: b\tDuration: 1500.00 ms\tBilled Duration: 1500 ms\tMemory Size: 128 MB\tMax Memory Used: 64 MB\t",
+			"START RequestId: c Version: $LATEST",
+			"REPORT RequestId: c\tDuration: 5.00 ms\tBilled Duration: 5 ms\tMemory Size: 128 MB\tMax Memory Used: 64 MB\tStatus: error\tError Type: Runtime.ExitError",
+		}
+
+		ids := func(list []*Invocation) (ids []string) {
+			for _, inv := range list {
+				ids = append(ids, inv.RequestID)
+			}
+			return
+		}
+
+		assert.Equal(t, []string{"c"}, ids(invocations(&Requests{Failed: true}, "s1", messages...)))
+		assert.Equal(t, []string{"b"}, ids(invocations(&Requests{Slow: time.Second}, "s1", messages...)))
+		assert.Equal(t, []string{"b", "c"}, ids(invocations(&Requests{Failed: true, Slow: time.Second}, "s1", messages...)))
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		list := invocations(&Requests{Filter: Filter{Where: map[string]string{"user": "tj"}}}, "s1",
+			"START RequestId: a Version: $LATEST",
+			`2024-03-01T10:00:00.000Z	a	INFO	{"user":"tj"}`,
+			"REPORT RequestId: a\tDuration: 5.00 ms\tBilled Duration: 5 ms\tMemory Size: 128 MB\tMax Memory Used: 64 MB\t",
+			"START RequestId: b Version: $LATEST",
+			`2024-03-01T10:00:00.000Z	b	INFO	{"user":"other"}`,
+			"REPORT RequestId: b\tDuration: 5.00 ms\tBilled Duration: 5 ms\tMemory Size: 128 MB\tMax Memory Used: 64 MB\t",
+		)
+
+		assert.Len(t, list, 1)
+		assert.Equal(t, "a", list[0].RequestID)
+	})
+}
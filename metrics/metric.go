@@ -2,139 +2,280 @@ package metrics
 
 import (
 	"fmt"
-	"sync"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 )
 
-var metricsNames = []string{"Invocations", "Errors", "Duration", "Throttles"}
+var metricsNames = []string{
+	"Invocations",
+	"Errors",
+	"Duration",
+	"Throttles",
+	"ConcurrentExecutions",
+	"IteratorAge",
+	"DeadLetterErrors",
+}
+
+// Stat is a statistic of a metric, such as the "Sum" of "Invocations" or the "p99" of "Duration".
+type Stat struct {
+	Metric string
+	Stat   string
+}
+
+// String implementation.
+func (s Stat) String() string {
+	return s.Metric + " " + s.Stat
+}
+
+// id returns the query id of the statistic, which must be lowercase alphanumeric.
+func (s Stat) id() string {
+	return strings.ToLower(s.Metric + "_" + strings.Replace(s.Stat, ".", "_", -1))
+}
+
+// Stats returns the statistics collected for metric `name`.
+func Stats(name string) []Stat {
+	var stats []string
+
+	switch name {
+	case "Duration":
+		stats = []string{"Sum", "Average", "p50", "p90", "p99", "Maximum"}
+	case "ConcurrentExecutions", "IteratorAge":
+		stats = []string{"Maximum"}
+	default:
+		stats = []string{"Sum"}
+	}
+
+	var list []Stat
+	for _, s := range stats {
+		list = append(list, Stat{Metric: name, Stat: s})
+	}
+
+	return list
+}
+
+// Point of a time series.
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series is the time series of a statistic.
+type Series struct {
+	Stat
+	Points []Point
+}
+
+// Value returns the statistic over all points: the sum of sums, the
+// maximum of maximums, and the mean of other statistics such as percentiles.
+func (s Series) Value() float64 {
+	if len(s.Points) == 0 {
+		return 0
+	}
+
+	var v float64
+
+	switch s.Stat.Stat {
+	case "Sum":
+		for _, p := range s.Points {
+			v += p.Value
+		}
+	case "Maximum":
+		for _, p := range s.Points {
+			if p.Value > v {
+				v = p.Value
+			}
+		}
+	default:
+		for _, p := range s.Points {
+			v += p.Value
+		}
+		v /= float64(len(s.Points))
+	}
+
+	return v
+}
 
 // Metric collects metrics for single function.
 type Metric struct {
 	Config
 	FunctionName string
+
+	// Resource optionally scopes metrics to an alias or version, e.g. "name:alias".
+	Resource string
+
+	// ExecutedVersion optionally scopes Resource metrics to a single version,
+	// which is how Lambda reports traffic routed between alias versions.
+	ExecutedVersion string
 }
 
 // Collect and aggregate metrics for on function.
-func (m *Metric) Collect() (a AggregatedMetrics) {
-	for n := range m.collect(m.gen()) {
-		value := aggregate(n.Value)
-
-		switch n.Name {
-		case "Duration":
-			a.Duration = value
-		case "Errors":
-			a.Errors = value
-		case "Invocations":
-			a.Invocations = value
-		case "Throttles":
-			a.Throttles = value
+func (m *Metric) Collect() (a AggregatedMetrics, err error) {
+	series, err := m.Series(total(m.StartDate, m.EndDate))
+	if err != nil {
+		return
+	}
+
+	for _, s := range series {
+		value := s.Value()
+
+		switch s.Stat {
+		case Stat{"Invocations", "Sum"}:
+			a.Invocations = int(value)
+		case Stat{"Errors", "Sum"}:
+			a.Errors = int(value)
+		case Stat{"Throttles", "Sum"}:
+			a.Throttles = int(value)
+		case Stat{"DeadLetterErrors", "Sum"}:
+			a.DeadLetterErrors = int(value)
+		case Stat{"ConcurrentExecutions", "Maximum"}:
+			a.ConcurrentExecutions = int(value)
+		case Stat{"IteratorAge", "Maximum"}:
+			a.IteratorAge = value
+		case Stat{"Duration", "Sum"}:
+			a.Duration = int(value)
+		case Stat{"Duration", "Average"}:
+			a.DurationAverage = value
+		case Stat{"Duration", "p50"}:
+			a.DurationP50 = value
+		case Stat{"Duration", "p90"}:
+			a.DurationP90 = value
+		case Stat{"Duration", "p99"}:
+			a.DurationP99 = value
+		case Stat{"Duration", "Maximum"}:
+			a.DurationMax = value
 		}
 	}
 
 	return
 }
 
-// cloudWatchMetric represents a CloudWatch metric with a given name and value.
-type cloudWatchMetric struct {
-	Name  string
-	Value []*cloudwatch.Datapoint
-}
+// Series returns the time series of the statistics of each metric in buckets of `period`,
+// which is rounded up to a minute, in the order of the metric names.
+func (m *Metric) Series(period time.Duration) ([]Series, error) {
+	if period < time.Minute {
+		period = time.Minute
+	}
 
-// stats for function `name`.
-func (m *Metric) stats(name string) (*cloudwatch.GetMetricStatisticsOutput, error) {
-	return m.Service.GetMetricStatistics(&cloudwatch.GetMetricStatisticsInput{
-		StartTime:  &m.StartDate,
-		EndTime:    &m.EndDate,
-		MetricName: &name,
-		Namespace:  aws.String("AWS/Lambda"),
-		Period:     aws.Int64(int64(period(m.StartDate, m.EndDate).Seconds())),
-		Statistics: []*string{
-			aws.String("Sum"),
-		},
-		Dimensions: []*cloudwatch.Dimension{
-			{
-				Name:  aws.String("FunctionName"),
-				Value: &m.FunctionName,
+	seconds := int64((period + time.Minute - 1) / time.Minute * 60)
+
+	var stats []Stat
+	for _, name := range m.names() {
+		stats = append(stats, Stats(name)...)
+	}
+
+	var queries []*cloudwatch.MetricDataQuery
+	for _, s := range stats {
+		queries = append(queries, &cloudwatch.MetricDataQuery{
+			Id: aws.String(s.id()),
+			MetricStat: &cloudwatch.MetricStat{
+				Metric: &cloudwatch.Metric{
+					Namespace:  aws.String("AWS/Lambda"),
+					MetricName: aws.String(s.Metric),
+					Dimensions: m.dimensions(),
+				},
+				Period: aws.Int64(seconds),
+				Stat:   aws.String(s.Stat),
 			},
-		},
-		Unit: aws.String(unit(name)),
-	})
-}
+		})
+	}
 
-// collect starts a new cloudwatch session and requests the key metrics.
-func (m *Metric) collect(in <-chan string) <-chan cloudWatchMetric {
-	var wg sync.WaitGroup
-	out := make(chan cloudWatchMetric)
+	points := make(map[string][]Point)
+	var nextToken *string
 
-	for name := range in {
-		wg.Add(1)
-		name := name
+	for {
+		res, err := m.Service.GetMetricData(&cloudwatch.GetMetricDataInput{
+			StartTime:         &m.StartDate,
+			EndTime:           &m.EndDate,
+			MetricDataQueries: queries,
+			ScanBy:            aws.String(cloudwatch.ScanByTimestampAscending),
+			NextToken:         nextToken,
+		})
 
-		go func() {
-			defer wg.Done()
+		if err != nil {
+			return nil, err
+		}
 
-			res, err := m.stats(name)
-			if err != nil {
-				// TODO: refactor so that errors are reported in cmd
-				fmt.Println(err.Error())
-				return
+		for _, r := range res.MetricDataResults {
+			if aws.StringValue(r.StatusCode) == cloudwatch.StatusCodeForbidden || aws.StringValue(r.StatusCode) == cloudwatch.StatusCodeInternalError {
+				return nil, fmt.Errorf("metric %s: %s", aws.StringValue(r.Label), strings.ToLower(aws.StringValue(r.StatusCode)))
 			}
 
-			out <- cloudWatchMetric{
-				Name:  name,
-				Value: res.Datapoints,
+			id := aws.StringValue(r.Id)
+			for i, t := range r.Timestamps {
+				points[id] = append(points[id], Point{
+					Time:  *t,
+					Value: *r.Values[i],
+				})
 			}
-		}()
+		}
+
+		if res.NextToken == nil {
+			break
+		}
+
+		nextToken = res.NextToken
 	}
 
-	go func() {
-		wg.Wait()
-		close(out)
-	}()
+	var list []Series
+	for _, s := range stats {
+		p := points[s.id()]
+		sort.Slice(p, func(i, j int) bool {
+			return p[i].Time.Before(p[j].Time)
+		})
 
-	return out
+		list = append(list, Series{Stat: s, Points: p})
+	}
+
+	return list, nil
 }
 
-// gen generates the key metric structs and returns a channel pipeline.
-func (m *Metric) gen() <-chan string {
-	out := make(chan string, len(metricsNames))
-	for _, n := range metricsNames {
-		out <- n
+// names returns the configured metric names, or all of them.
+func (m *Metric) names() []string {
+	if len(m.MetricNames) == 0 {
+		return metricsNames
 	}
-	close(out)
-	return out
+
+	return m.MetricNames
 }
 
-// period returns the resolution of metrics.
-func period(start, end time.Time) time.Duration {
-	switch n := end.Sub(start).Hours(); {
-	case n > 24:
-		return time.Hour * 24
-	default:
-		return time.Hour
+// dimensions returns the CloudWatch dimensions for the metric.
+func (m *Metric) dimensions() []*cloudwatch.Dimension {
+	d := []*cloudwatch.Dimension{
+		{
+			Name:  aws.String("FunctionName"),
+			Value: aws.String(m.FunctionName),
+		},
 	}
-}
 
-// unit for metric name.
-func unit(name string) string {
-	switch name {
-	case "Duration":
-		return "Milliseconds"
-	default:
-		return "Count"
+	if m.Resource != "" {
+		d = append(d, &cloudwatch.Dimension{
+			Name:  aws.String("Resource"),
+			Value: aws.String(m.Resource),
+		})
+	}
+
+	if m.ExecutedVersion != "" {
+		d = append(d, &cloudwatch.Dimension{
+			Name:  aws.String("ExecutedVersion"),
+			Value: aws.String(m.ExecutedVersion),
+		})
 	}
+
+	return d
 }
 
-// aggregate accumulates the datapoints.
-func aggregate(datapoints []*cloudwatch.Datapoint) int {
-	sum := 0.0
+// total returns the period covering the range from `start` to `end` in a single
+// bucket, in hours beyond a day as CloudWatch requires for older metrics.
+func total(start, end time.Time) time.Duration {
+	d := end.Sub(start)
 
-	for _, datapoint := range datapoints {
-		sum += *datapoint.Sum
+	if d > 24*time.Hour {
+		return (d + time.Hour - 1) / time.Hour * time.Hour
 	}
 
-	return int(sum)
+	return d
 }
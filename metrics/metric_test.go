@@ -0,0 +1,125 @@
+package metrics_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/metrics"
+)
+
+// service responds with two points per query, in pages of `pageSize` results.
+type service struct {
+	cloudwatchiface.CloudWatchAPI
+	input    *cloudwatch.GetMetricDataInput
+	pageSize int
+	err      error
+}
+
+func (s *service) GetMetricData(in *cloudwatch.GetMetricDataInput) (*cloudwatch.GetMetricDataOutput, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	s.input = in
+
+	var start int
+	if in.NextToken != nil {
+		start, _ = strconv.Atoi(*in.NextToken)
+	}
+
+	end := start + s.pageSize
+	if end > len(in.MetricDataQueries) {
+		end = len(in.MetricDataQueries)
+	}
+
+	t := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	out := &cloudwatch.GetMetricDataOutput{}
+
+	for _, q := range in.MetricDataQueries[start:end] {
+		out.MetricDataResults = append(out.MetricDataResults, &cloudwatch.MetricDataResult{
+			Id:         q.Id,
+			StatusCode: aws.String("Complete"),
+			Timestamps: []*time.Time{aws.Time(t), aws.Time(t.Add(time.Hour))},
+			Values:     []*float64{aws.Float64(10), aws.Float64(30)},
+		})
+	}
+
+	if end < len(in.MetricDataQueries) {
+		out.NextToken = aws.String(strconv.Itoa(end))
+	}
+
+	return out, nil
+}
+
+func TestMetric_Collect(t *testing.T) {
+	s := &service{pageSize: 5}
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	m := metrics.Metric{
+		Config: metrics.Config{
+			Service:   s,
+			StartDate: start,
+			EndDate:   start.Add(2 * time.Hour),
+		},
+		FunctionName: "app_api",
+		Resource:     "app_api:current",
+	}
+
+	a, err := m.Collect()
+	assert.NoError(t, err)
+
+	assert.Equal(t, 40, a.Invocations)
+	assert.Equal(t, 40, a.Errors)
+	assert.Equal(t, 40, a.Duration)
+	assert.Equal(t, 20.0, a.DurationP99)
+	assert.Equal(t, 30.0, a.DurationMax)
+	assert.Equal(t, 30, a.ConcurrentExecutions)
+	assert.Equal(t, 30.0, a.IteratorAge)
+	assert.Equal(t, 40, a.DeadLetterErrors)
+
+	q := s.input.MetricDataQueries[0]
+	assert.Equal(t, int64(7200), *q.MetricStat.Period)
+	assert.Equal(t, "Resource", *q.MetricStat.Metric.Dimensions[1].Name)
+	assert.Equal(t, "app_api:current", *q.MetricStat.Metric.Dimensions[1].Value)
+}
+
+func TestMetric_Series(t *testing.T) {
+	s := &service{pageSize: 100}
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	m := metrics.Metric{
+		Config: metrics.Config{
+			MetricNames: []string{"Duration"},
+			Service:     s,
+			StartDate:   start,
+			EndDate:     start.Add(2 * time.Hour),
+		},
+		FunctionName: "app_api",
+	}
+
+	series, err := m.Series(90 * time.Second)
+	assert.NoError(t, err)
+	assert.Len(t, series, 6)
+	assert.Equal(t, metrics.Stat{Metric: "Duration", Stat: "p50"}, series[2].Stat)
+	assert.Equal(t, []metrics.Point{{start, 10}, {start.Add(time.Hour), 30}}, series[2].Points)
+	assert.Equal(t, int64(120), *s.input.MetricDataQueries[0].MetricStat.Period)
+}
+
+func TestMetric_Collect_error(t *testing.T) {
+	m := metrics.Metric{
+		Config: metrics.Config{
+			Service: &service{err: errors.New("throttled")},
+		},
+		FunctionName: "app_api",
+	}
+
+	_, err := m.Collect()
+	assert.EqualError(t, err, "throttled")
+}
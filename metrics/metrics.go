@@ -2,6 +2,7 @@
 package metrics
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
@@ -15,32 +16,87 @@ type Config struct {
 	EndDate     time.Time
 }
 
-// AggregatedMetrics represents aggregated metrics.
+// AggregatedMetrics represents aggregated metrics, durations in milliseconds.
 type AggregatedMetrics struct {
-	Duration    int
-	Errors      int
-	Invocations int
-	Throttles   int
+	Duration             int
+	DurationAverage      float64
+	DurationP50          float64
+	DurationP90          float64
+	DurationP99          float64
+	DurationMax          float64
+	Errors               int
+	Invocations          int
+	Throttles            int
+	ConcurrentExecutions int
+	IteratorAge          float64
+	DeadLetterErrors     int
 }
 
 // Metrics collects CloudWatch metrics for multiple functions
 type Metrics struct {
 	Config
 	FunctionNames []string
+
+	// Alias optionally scopes metrics to the alias of each function.
+	Alias string
+
+	// Version optionally scopes metrics to a version of each function,
+	// the version executed through the alias when both are specified.
+	Version string
 }
 
 // Collect and aggregate metrics for multiple functions.
-func (m *Metrics) Collect() (a map[string]AggregatedMetrics) {
-	a = make(map[string]AggregatedMetrics)
+func (m *Metrics) Collect() (map[string]AggregatedMetrics, error) {
+	a := make(map[string]AggregatedMetrics)
+
+	for _, name := range m.FunctionNames {
+		metric := m.metric(name)
 
-	for _, fnName := range m.FunctionNames {
-		metric := Metric{
-			Config:       m.Config,
-			FunctionName: fnName,
+		v, err := metric.Collect()
+		if err != nil {
+			return nil, fmt.Errorf("function %s: %s", name, err)
 		}
 
-		a[fnName] = metric.Collect()
+		a[name] = v
+	}
+
+	return a, nil
+}
+
+// Series returns the time series of metrics for multiple functions in buckets of `period`.
+func (m *Metrics) Series(period time.Duration) (map[string][]Series, error) {
+	s := make(map[string][]Series)
+
+	for _, name := range m.FunctionNames {
+		metric := m.metric(name)
+
+		v, err := metric.Series(period)
+		if err != nil {
+			return nil, fmt.Errorf("function %s: %s", name, err)
+		}
+
+		s[name] = v
+	}
+
+	return s, nil
+}
+
+// metric returns the metric of function `name`, scoped to the alias or version.
+func (m *Metrics) metric(name string) Metric {
+	metric := Metric{
+		Config:       m.Config,
+		FunctionName: name,
+	}
+
+	switch {
+	case m.Alias != "" && m.Version != "":
+		metric.Resource = name + ":" + m.Alias
+		metric.ExecutedVersion = m.Version
+	case m.Alias != "":
+		metric.Resource = name + ":" + m.Alias
+	case m.Version != "":
+		metric.Resource = name + ":" + m.Version
 	}
 
-	return
+	return metric
 }
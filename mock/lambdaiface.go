@@ -1,11 +1,10 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: github.com/aws/aws-sdk-go/service/lambda/lambdaiface (interfaces: LambdaAPI)
 
-// Package mock_lambdaiface is a generated GoMock package.
 package mock_lambdaiface
 
 import (
-	aws "github.com/aws/aws-sdk-go/aws"
+	context "context"
 	request "github.com/aws/aws-sdk-go/aws/request"
 	lambda "github.com/aws/aws-sdk-go/service/lambda"
 	gomock "github.com/golang/mock/gomock"
@@ -31,1384 +30,3403 @@ func NewMockLambdaAPI(ctrl *gomock.Controller) *MockLambdaAPI {
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockLambdaAPI) EXPECT() *MockLambdaAPIMockRecorder {
-	return m.recorder
+func (_m *MockLambdaAPI) EXPECT() *MockLambdaAPIMockRecorder {
+	return _m.recorder
+}
+
+// AddLayerVersionPermission mocks base method
+func (_m *MockLambdaAPI) AddLayerVersionPermission(_param0 *lambda.AddLayerVersionPermissionInput) (*lambda.AddLayerVersionPermissionOutput, error) {
+	ret := _m.ctrl.Call(_m, "AddLayerVersionPermission", _param0)
+	ret0, _ := ret[0].(*lambda.AddLayerVersionPermissionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddLayerVersionPermission indicates an expected call of AddLayerVersionPermission
+func (_mr *MockLambdaAPIMockRecorder) AddLayerVersionPermission(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "AddLayerVersionPermission", reflect.TypeOf((*MockLambdaAPI)(nil).AddLayerVersionPermission), arg0)
+}
+
+// AddLayerVersionPermissionRequest mocks base method
+func (_m *MockLambdaAPI) AddLayerVersionPermissionRequest(_param0 *lambda.AddLayerVersionPermissionInput) (*request.Request, *lambda.AddLayerVersionPermissionOutput) {
+	ret := _m.ctrl.Call(_m, "AddLayerVersionPermissionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.AddLayerVersionPermissionOutput)
+	return ret0, ret1
+}
+
+// AddLayerVersionPermissionRequest indicates an expected call of AddLayerVersionPermissionRequest
+func (_mr *MockLambdaAPIMockRecorder) AddLayerVersionPermissionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "AddLayerVersionPermissionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).AddLayerVersionPermissionRequest), arg0)
+}
+
+// AddLayerVersionPermissionWithContext mocks base method
+func (_m *MockLambdaAPI) AddLayerVersionPermissionWithContext(_param0 context.Context, _param1 *lambda.AddLayerVersionPermissionInput, _param2 ...request.Option) (*lambda.AddLayerVersionPermissionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AddLayerVersionPermissionWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.AddLayerVersionPermissionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddLayerVersionPermissionWithContext indicates an expected call of AddLayerVersionPermissionWithContext
+func (_mr *MockLambdaAPIMockRecorder) AddLayerVersionPermissionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "AddLayerVersionPermissionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).AddLayerVersionPermissionWithContext), _s...)
 }
 
 // AddPermission mocks base method
-func (m *MockLambdaAPI) AddPermission(arg0 *lambda.AddPermissionInput) (*lambda.AddPermissionOutput, error) {
-	ret := m.ctrl.Call(m, "AddPermission", arg0)
+func (_m *MockLambdaAPI) AddPermission(_param0 *lambda.AddPermissionInput) (*lambda.AddPermissionOutput, error) {
+	ret := _m.ctrl.Call(_m, "AddPermission", _param0)
 	ret0, _ := ret[0].(*lambda.AddPermissionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddPermission indicates an expected call of AddPermission
-func (mr *MockLambdaAPIMockRecorder) AddPermission(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPermission", reflect.TypeOf((*MockLambdaAPI)(nil).AddPermission), arg0)
+func (_mr *MockLambdaAPIMockRecorder) AddPermission(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "AddPermission", reflect.TypeOf((*MockLambdaAPI)(nil).AddPermission), arg0)
 }
 
 // AddPermissionRequest mocks base method
-func (m *MockLambdaAPI) AddPermissionRequest(arg0 *lambda.AddPermissionInput) (*request.Request, *lambda.AddPermissionOutput) {
-	ret := m.ctrl.Call(m, "AddPermissionRequest", arg0)
+func (_m *MockLambdaAPI) AddPermissionRequest(_param0 *lambda.AddPermissionInput) (*request.Request, *lambda.AddPermissionOutput) {
+	ret := _m.ctrl.Call(_m, "AddPermissionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.AddPermissionOutput)
 	return ret0, ret1
 }
 
 // AddPermissionRequest indicates an expected call of AddPermissionRequest
-func (mr *MockLambdaAPIMockRecorder) AddPermissionRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPermissionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).AddPermissionRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) AddPermissionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "AddPermissionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).AddPermissionRequest), arg0)
 }
 
 // AddPermissionWithContext mocks base method
-func (m *MockLambdaAPI) AddPermissionWithContext(arg0 aws.Context, arg1 *lambda.AddPermissionInput, arg2 ...request.Option) (*lambda.AddPermissionOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) AddPermissionWithContext(_param0 context.Context, _param1 *lambda.AddPermissionInput, _param2 ...request.Option) (*lambda.AddPermissionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "AddPermissionWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "AddPermissionWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.AddPermissionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddPermissionWithContext indicates an expected call of AddPermissionWithContext
-func (mr *MockLambdaAPIMockRecorder) AddPermissionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPermissionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).AddPermissionWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) AddPermissionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "AddPermissionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).AddPermissionWithContext), _s...)
 }
 
 // CreateAlias mocks base method
-func (m *MockLambdaAPI) CreateAlias(arg0 *lambda.CreateAliasInput) (*lambda.AliasConfiguration, error) {
-	ret := m.ctrl.Call(m, "CreateAlias", arg0)
+func (_m *MockLambdaAPI) CreateAlias(_param0 *lambda.CreateAliasInput) (*lambda.AliasConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "CreateAlias", _param0)
 	ret0, _ := ret[0].(*lambda.AliasConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAlias indicates an expected call of CreateAlias
-func (mr *MockLambdaAPIMockRecorder) CreateAlias(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlias", reflect.TypeOf((*MockLambdaAPI)(nil).CreateAlias), arg0)
+func (_mr *MockLambdaAPIMockRecorder) CreateAlias(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateAlias", reflect.TypeOf((*MockLambdaAPI)(nil).CreateAlias), arg0)
 }
 
 // CreateAliasRequest mocks base method
-func (m *MockLambdaAPI) CreateAliasRequest(arg0 *lambda.CreateAliasInput) (*request.Request, *lambda.AliasConfiguration) {
-	ret := m.ctrl.Call(m, "CreateAliasRequest", arg0)
+func (_m *MockLambdaAPI) CreateAliasRequest(_param0 *lambda.CreateAliasInput) (*request.Request, *lambda.AliasConfiguration) {
+	ret := _m.ctrl.Call(_m, "CreateAliasRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.AliasConfiguration)
 	return ret0, ret1
 }
 
 // CreateAliasRequest indicates an expected call of CreateAliasRequest
-func (mr *MockLambdaAPIMockRecorder) CreateAliasRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAliasRequest", reflect.TypeOf((*MockLambdaAPI)(nil).CreateAliasRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) CreateAliasRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateAliasRequest", reflect.TypeOf((*MockLambdaAPI)(nil).CreateAliasRequest), arg0)
 }
 
 // CreateAliasWithContext mocks base method
-func (m *MockLambdaAPI) CreateAliasWithContext(arg0 aws.Context, arg1 *lambda.CreateAliasInput, arg2 ...request.Option) (*lambda.AliasConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) CreateAliasWithContext(_param0 context.Context, _param1 *lambda.CreateAliasInput, _param2 ...request.Option) (*lambda.AliasConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "CreateAliasWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "CreateAliasWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.AliasConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateAliasWithContext indicates an expected call of CreateAliasWithContext
-func (mr *MockLambdaAPIMockRecorder) CreateAliasWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAliasWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).CreateAliasWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) CreateAliasWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateAliasWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).CreateAliasWithContext), _s...)
+}
+
+// CreateCodeSigningConfig mocks base method
+func (_m *MockLambdaAPI) CreateCodeSigningConfig(_param0 *lambda.CreateCodeSigningConfigInput) (*lambda.CreateCodeSigningConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "CreateCodeSigningConfig", _param0)
+	ret0, _ := ret[0].(*lambda.CreateCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCodeSigningConfig indicates an expected call of CreateCodeSigningConfig
+func (_mr *MockLambdaAPIMockRecorder) CreateCodeSigningConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateCodeSigningConfig", reflect.TypeOf((*MockLambdaAPI)(nil).CreateCodeSigningConfig), arg0)
+}
+
+// CreateCodeSigningConfigRequest mocks base method
+func (_m *MockLambdaAPI) CreateCodeSigningConfigRequest(_param0 *lambda.CreateCodeSigningConfigInput) (*request.Request, *lambda.CreateCodeSigningConfigOutput) {
+	ret := _m.ctrl.Call(_m, "CreateCodeSigningConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.CreateCodeSigningConfigOutput)
+	return ret0, ret1
+}
+
+// CreateCodeSigningConfigRequest indicates an expected call of CreateCodeSigningConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) CreateCodeSigningConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateCodeSigningConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).CreateCodeSigningConfigRequest), arg0)
+}
+
+// CreateCodeSigningConfigWithContext mocks base method
+func (_m *MockLambdaAPI) CreateCodeSigningConfigWithContext(_param0 context.Context, _param1 *lambda.CreateCodeSigningConfigInput, _param2 ...request.Option) (*lambda.CreateCodeSigningConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateCodeSigningConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.CreateCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCodeSigningConfigWithContext indicates an expected call of CreateCodeSigningConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) CreateCodeSigningConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateCodeSigningConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).CreateCodeSigningConfigWithContext), _s...)
 }
 
 // CreateEventSourceMapping mocks base method
-func (m *MockLambdaAPI) CreateEventSourceMapping(arg0 *lambda.CreateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
-	ret := m.ctrl.Call(m, "CreateEventSourceMapping", arg0)
+func (_m *MockLambdaAPI) CreateEventSourceMapping(_param0 *lambda.CreateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "CreateEventSourceMapping", _param0)
 	ret0, _ := ret[0].(*lambda.EventSourceMappingConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateEventSourceMapping indicates an expected call of CreateEventSourceMapping
-func (mr *MockLambdaAPIMockRecorder) CreateEventSourceMapping(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventSourceMapping", reflect.TypeOf((*MockLambdaAPI)(nil).CreateEventSourceMapping), arg0)
+func (_mr *MockLambdaAPIMockRecorder) CreateEventSourceMapping(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateEventSourceMapping", reflect.TypeOf((*MockLambdaAPI)(nil).CreateEventSourceMapping), arg0)
 }
 
 // CreateEventSourceMappingRequest mocks base method
-func (m *MockLambdaAPI) CreateEventSourceMappingRequest(arg0 *lambda.CreateEventSourceMappingInput) (*request.Request, *lambda.EventSourceMappingConfiguration) {
-	ret := m.ctrl.Call(m, "CreateEventSourceMappingRequest", arg0)
+func (_m *MockLambdaAPI) CreateEventSourceMappingRequest(_param0 *lambda.CreateEventSourceMappingInput) (*request.Request, *lambda.EventSourceMappingConfiguration) {
+	ret := _m.ctrl.Call(_m, "CreateEventSourceMappingRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.EventSourceMappingConfiguration)
 	return ret0, ret1
 }
 
 // CreateEventSourceMappingRequest indicates an expected call of CreateEventSourceMappingRequest
-func (mr *MockLambdaAPIMockRecorder) CreateEventSourceMappingRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventSourceMappingRequest", reflect.TypeOf((*MockLambdaAPI)(nil).CreateEventSourceMappingRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) CreateEventSourceMappingRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateEventSourceMappingRequest", reflect.TypeOf((*MockLambdaAPI)(nil).CreateEventSourceMappingRequest), arg0)
 }
 
 // CreateEventSourceMappingWithContext mocks base method
-func (m *MockLambdaAPI) CreateEventSourceMappingWithContext(arg0 aws.Context, arg1 *lambda.CreateEventSourceMappingInput, arg2 ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) CreateEventSourceMappingWithContext(_param0 context.Context, _param1 *lambda.CreateEventSourceMappingInput, _param2 ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "CreateEventSourceMappingWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "CreateEventSourceMappingWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.EventSourceMappingConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateEventSourceMappingWithContext indicates an expected call of CreateEventSourceMappingWithContext
-func (mr *MockLambdaAPIMockRecorder) CreateEventSourceMappingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventSourceMappingWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).CreateEventSourceMappingWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) CreateEventSourceMappingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateEventSourceMappingWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).CreateEventSourceMappingWithContext), _s...)
 }
 
 // CreateFunction mocks base method
-func (m *MockLambdaAPI) CreateFunction(arg0 *lambda.CreateFunctionInput) (*lambda.FunctionConfiguration, error) {
-	ret := m.ctrl.Call(m, "CreateFunction", arg0)
+func (_m *MockLambdaAPI) CreateFunction(_param0 *lambda.CreateFunctionInput) (*lambda.FunctionConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "CreateFunction", _param0)
 	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateFunction indicates an expected call of CreateFunction
-func (mr *MockLambdaAPIMockRecorder) CreateFunction(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFunction", reflect.TypeOf((*MockLambdaAPI)(nil).CreateFunction), arg0)
+func (_mr *MockLambdaAPIMockRecorder) CreateFunction(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateFunction", reflect.TypeOf((*MockLambdaAPI)(nil).CreateFunction), arg0)
 }
 
 // CreateFunctionRequest mocks base method
-func (m *MockLambdaAPI) CreateFunctionRequest(arg0 *lambda.CreateFunctionInput) (*request.Request, *lambda.FunctionConfiguration) {
-	ret := m.ctrl.Call(m, "CreateFunctionRequest", arg0)
+func (_m *MockLambdaAPI) CreateFunctionRequest(_param0 *lambda.CreateFunctionInput) (*request.Request, *lambda.FunctionConfiguration) {
+	ret := _m.ctrl.Call(_m, "CreateFunctionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.FunctionConfiguration)
 	return ret0, ret1
 }
 
 // CreateFunctionRequest indicates an expected call of CreateFunctionRequest
-func (mr *MockLambdaAPIMockRecorder) CreateFunctionRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFunctionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).CreateFunctionRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) CreateFunctionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateFunctionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).CreateFunctionRequest), arg0)
+}
+
+// CreateFunctionUrlConfig mocks base method
+func (_m *MockLambdaAPI) CreateFunctionUrlConfig(_param0 *lambda.CreateFunctionUrlConfigInput) (*lambda.CreateFunctionUrlConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "CreateFunctionUrlConfig", _param0)
+	ret0, _ := ret[0].(*lambda.CreateFunctionUrlConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFunctionUrlConfig indicates an expected call of CreateFunctionUrlConfig
+func (_mr *MockLambdaAPIMockRecorder) CreateFunctionUrlConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateFunctionUrlConfig", reflect.TypeOf((*MockLambdaAPI)(nil).CreateFunctionUrlConfig), arg0)
+}
+
+// CreateFunctionUrlConfigRequest mocks base method
+func (_m *MockLambdaAPI) CreateFunctionUrlConfigRequest(_param0 *lambda.CreateFunctionUrlConfigInput) (*request.Request, *lambda.CreateFunctionUrlConfigOutput) {
+	ret := _m.ctrl.Call(_m, "CreateFunctionUrlConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.CreateFunctionUrlConfigOutput)
+	return ret0, ret1
+}
+
+// CreateFunctionUrlConfigRequest indicates an expected call of CreateFunctionUrlConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) CreateFunctionUrlConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateFunctionUrlConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).CreateFunctionUrlConfigRequest), arg0)
+}
+
+// CreateFunctionUrlConfigWithContext mocks base method
+func (_m *MockLambdaAPI) CreateFunctionUrlConfigWithContext(_param0 context.Context, _param1 *lambda.CreateFunctionUrlConfigInput, _param2 ...request.Option) (*lambda.CreateFunctionUrlConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "CreateFunctionUrlConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.CreateFunctionUrlConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFunctionUrlConfigWithContext indicates an expected call of CreateFunctionUrlConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) CreateFunctionUrlConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateFunctionUrlConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).CreateFunctionUrlConfigWithContext), _s...)
 }
 
 // CreateFunctionWithContext mocks base method
-func (m *MockLambdaAPI) CreateFunctionWithContext(arg0 aws.Context, arg1 *lambda.CreateFunctionInput, arg2 ...request.Option) (*lambda.FunctionConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) CreateFunctionWithContext(_param0 context.Context, _param1 *lambda.CreateFunctionInput, _param2 ...request.Option) (*lambda.FunctionConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "CreateFunctionWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "CreateFunctionWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateFunctionWithContext indicates an expected call of CreateFunctionWithContext
-func (mr *MockLambdaAPIMockRecorder) CreateFunctionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFunctionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).CreateFunctionWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) CreateFunctionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "CreateFunctionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).CreateFunctionWithContext), _s...)
 }
 
 // DeleteAlias mocks base method
-func (m *MockLambdaAPI) DeleteAlias(arg0 *lambda.DeleteAliasInput) (*lambda.DeleteAliasOutput, error) {
-	ret := m.ctrl.Call(m, "DeleteAlias", arg0)
+func (_m *MockLambdaAPI) DeleteAlias(_param0 *lambda.DeleteAliasInput) (*lambda.DeleteAliasOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteAlias", _param0)
 	ret0, _ := ret[0].(*lambda.DeleteAliasOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteAlias indicates an expected call of DeleteAlias
-func (mr *MockLambdaAPIMockRecorder) DeleteAlias(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlias", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteAlias), arg0)
+func (_mr *MockLambdaAPIMockRecorder) DeleteAlias(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteAlias", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteAlias), arg0)
 }
 
 // DeleteAliasRequest mocks base method
-func (m *MockLambdaAPI) DeleteAliasRequest(arg0 *lambda.DeleteAliasInput) (*request.Request, *lambda.DeleteAliasOutput) {
-	ret := m.ctrl.Call(m, "DeleteAliasRequest", arg0)
+func (_m *MockLambdaAPI) DeleteAliasRequest(_param0 *lambda.DeleteAliasInput) (*request.Request, *lambda.DeleteAliasOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteAliasRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.DeleteAliasOutput)
 	return ret0, ret1
 }
 
 // DeleteAliasRequest indicates an expected call of DeleteAliasRequest
-func (mr *MockLambdaAPIMockRecorder) DeleteAliasRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAliasRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteAliasRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) DeleteAliasRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteAliasRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteAliasRequest), arg0)
 }
 
 // DeleteAliasWithContext mocks base method
-func (m *MockLambdaAPI) DeleteAliasWithContext(arg0 aws.Context, arg1 *lambda.DeleteAliasInput, arg2 ...request.Option) (*lambda.DeleteAliasOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) DeleteAliasWithContext(_param0 context.Context, _param1 *lambda.DeleteAliasInput, _param2 ...request.Option) (*lambda.DeleteAliasOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "DeleteAliasWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "DeleteAliasWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.DeleteAliasOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteAliasWithContext indicates an expected call of DeleteAliasWithContext
-func (mr *MockLambdaAPIMockRecorder) DeleteAliasWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAliasWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteAliasWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) DeleteAliasWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteAliasWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteAliasWithContext), _s...)
+}
+
+// DeleteCodeSigningConfig mocks base method
+func (_m *MockLambdaAPI) DeleteCodeSigningConfig(_param0 *lambda.DeleteCodeSigningConfigInput) (*lambda.DeleteCodeSigningConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteCodeSigningConfig", _param0)
+	ret0, _ := ret[0].(*lambda.DeleteCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCodeSigningConfig indicates an expected call of DeleteCodeSigningConfig
+func (_mr *MockLambdaAPIMockRecorder) DeleteCodeSigningConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteCodeSigningConfig", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteCodeSigningConfig), arg0)
+}
+
+// DeleteCodeSigningConfigRequest mocks base method
+func (_m *MockLambdaAPI) DeleteCodeSigningConfigRequest(_param0 *lambda.DeleteCodeSigningConfigInput) (*request.Request, *lambda.DeleteCodeSigningConfigOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteCodeSigningConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.DeleteCodeSigningConfigOutput)
+	return ret0, ret1
+}
+
+// DeleteCodeSigningConfigRequest indicates an expected call of DeleteCodeSigningConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) DeleteCodeSigningConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteCodeSigningConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteCodeSigningConfigRequest), arg0)
+}
+
+// DeleteCodeSigningConfigWithContext mocks base method
+func (_m *MockLambdaAPI) DeleteCodeSigningConfigWithContext(_param0 context.Context, _param1 *lambda.DeleteCodeSigningConfigInput, _param2 ...request.Option) (*lambda.DeleteCodeSigningConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteCodeSigningConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.DeleteCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCodeSigningConfigWithContext indicates an expected call of DeleteCodeSigningConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) DeleteCodeSigningConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteCodeSigningConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteCodeSigningConfigWithContext), _s...)
 }
 
 // DeleteEventSourceMapping mocks base method
-func (m *MockLambdaAPI) DeleteEventSourceMapping(arg0 *lambda.DeleteEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
-	ret := m.ctrl.Call(m, "DeleteEventSourceMapping", arg0)
+func (_m *MockLambdaAPI) DeleteEventSourceMapping(_param0 *lambda.DeleteEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "DeleteEventSourceMapping", _param0)
 	ret0, _ := ret[0].(*lambda.EventSourceMappingConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteEventSourceMapping indicates an expected call of DeleteEventSourceMapping
-func (mr *MockLambdaAPIMockRecorder) DeleteEventSourceMapping(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventSourceMapping", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteEventSourceMapping), arg0)
+func (_mr *MockLambdaAPIMockRecorder) DeleteEventSourceMapping(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteEventSourceMapping", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteEventSourceMapping), arg0)
 }
 
 // DeleteEventSourceMappingRequest mocks base method
-func (m *MockLambdaAPI) DeleteEventSourceMappingRequest(arg0 *lambda.DeleteEventSourceMappingInput) (*request.Request, *lambda.EventSourceMappingConfiguration) {
-	ret := m.ctrl.Call(m, "DeleteEventSourceMappingRequest", arg0)
+func (_m *MockLambdaAPI) DeleteEventSourceMappingRequest(_param0 *lambda.DeleteEventSourceMappingInput) (*request.Request, *lambda.EventSourceMappingConfiguration) {
+	ret := _m.ctrl.Call(_m, "DeleteEventSourceMappingRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.EventSourceMappingConfiguration)
 	return ret0, ret1
 }
 
 // DeleteEventSourceMappingRequest indicates an expected call of DeleteEventSourceMappingRequest
-func (mr *MockLambdaAPIMockRecorder) DeleteEventSourceMappingRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventSourceMappingRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteEventSourceMappingRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) DeleteEventSourceMappingRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteEventSourceMappingRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteEventSourceMappingRequest), arg0)
 }
 
 // DeleteEventSourceMappingWithContext mocks base method
-func (m *MockLambdaAPI) DeleteEventSourceMappingWithContext(arg0 aws.Context, arg1 *lambda.DeleteEventSourceMappingInput, arg2 ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) DeleteEventSourceMappingWithContext(_param0 context.Context, _param1 *lambda.DeleteEventSourceMappingInput, _param2 ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "DeleteEventSourceMappingWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "DeleteEventSourceMappingWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.EventSourceMappingConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteEventSourceMappingWithContext indicates an expected call of DeleteEventSourceMappingWithContext
-func (mr *MockLambdaAPIMockRecorder) DeleteEventSourceMappingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventSourceMappingWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteEventSourceMappingWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) DeleteEventSourceMappingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteEventSourceMappingWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteEventSourceMappingWithContext), _s...)
 }
 
 // DeleteFunction mocks base method
-func (m *MockLambdaAPI) DeleteFunction(arg0 *lambda.DeleteFunctionInput) (*lambda.DeleteFunctionOutput, error) {
-	ret := m.ctrl.Call(m, "DeleteFunction", arg0)
+func (_m *MockLambdaAPI) DeleteFunction(_param0 *lambda.DeleteFunctionInput) (*lambda.DeleteFunctionOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteFunction", _param0)
 	ret0, _ := ret[0].(*lambda.DeleteFunctionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteFunction indicates an expected call of DeleteFunction
-func (mr *MockLambdaAPIMockRecorder) DeleteFunction(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFunction", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunction), arg0)
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunction(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunction", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunction), arg0)
+}
+
+// DeleteFunctionCodeSigningConfig mocks base method
+func (_m *MockLambdaAPI) DeleteFunctionCodeSigningConfig(_param0 *lambda.DeleteFunctionCodeSigningConfigInput) (*lambda.DeleteFunctionCodeSigningConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteFunctionCodeSigningConfig", _param0)
+	ret0, _ := ret[0].(*lambda.DeleteFunctionCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFunctionCodeSigningConfig indicates an expected call of DeleteFunctionCodeSigningConfig
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionCodeSigningConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionCodeSigningConfig", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionCodeSigningConfig), arg0)
+}
+
+// DeleteFunctionCodeSigningConfigRequest mocks base method
+func (_m *MockLambdaAPI) DeleteFunctionCodeSigningConfigRequest(_param0 *lambda.DeleteFunctionCodeSigningConfigInput) (*request.Request, *lambda.DeleteFunctionCodeSigningConfigOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteFunctionCodeSigningConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.DeleteFunctionCodeSigningConfigOutput)
+	return ret0, ret1
+}
+
+// DeleteFunctionCodeSigningConfigRequest indicates an expected call of DeleteFunctionCodeSigningConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionCodeSigningConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionCodeSigningConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionCodeSigningConfigRequest), arg0)
+}
+
+// DeleteFunctionCodeSigningConfigWithContext mocks base method
+func (_m *MockLambdaAPI) DeleteFunctionCodeSigningConfigWithContext(_param0 context.Context, _param1 *lambda.DeleteFunctionCodeSigningConfigInput, _param2 ...request.Option) (*lambda.DeleteFunctionCodeSigningConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteFunctionCodeSigningConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.DeleteFunctionCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFunctionCodeSigningConfigWithContext indicates an expected call of DeleteFunctionCodeSigningConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionCodeSigningConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionCodeSigningConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionCodeSigningConfigWithContext), _s...)
 }
 
 // DeleteFunctionConcurrency mocks base method
-func (m *MockLambdaAPI) DeleteFunctionConcurrency(arg0 *lambda.DeleteFunctionConcurrencyInput) (*lambda.DeleteFunctionConcurrencyOutput, error) {
-	ret := m.ctrl.Call(m, "DeleteFunctionConcurrency", arg0)
+func (_m *MockLambdaAPI) DeleteFunctionConcurrency(_param0 *lambda.DeleteFunctionConcurrencyInput) (*lambda.DeleteFunctionConcurrencyOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteFunctionConcurrency", _param0)
 	ret0, _ := ret[0].(*lambda.DeleteFunctionConcurrencyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteFunctionConcurrency indicates an expected call of DeleteFunctionConcurrency
-func (mr *MockLambdaAPIMockRecorder) DeleteFunctionConcurrency(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFunctionConcurrency", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionConcurrency), arg0)
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionConcurrency(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionConcurrency", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionConcurrency), arg0)
 }
 
 // DeleteFunctionConcurrencyRequest mocks base method
-func (m *MockLambdaAPI) DeleteFunctionConcurrencyRequest(arg0 *lambda.DeleteFunctionConcurrencyInput) (*request.Request, *lambda.DeleteFunctionConcurrencyOutput) {
-	ret := m.ctrl.Call(m, "DeleteFunctionConcurrencyRequest", arg0)
+func (_m *MockLambdaAPI) DeleteFunctionConcurrencyRequest(_param0 *lambda.DeleteFunctionConcurrencyInput) (*request.Request, *lambda.DeleteFunctionConcurrencyOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteFunctionConcurrencyRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.DeleteFunctionConcurrencyOutput)
 	return ret0, ret1
 }
 
 // DeleteFunctionConcurrencyRequest indicates an expected call of DeleteFunctionConcurrencyRequest
-func (mr *MockLambdaAPIMockRecorder) DeleteFunctionConcurrencyRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFunctionConcurrencyRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionConcurrencyRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionConcurrencyRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionConcurrencyRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionConcurrencyRequest), arg0)
 }
 
 // DeleteFunctionConcurrencyWithContext mocks base method
-func (m *MockLambdaAPI) DeleteFunctionConcurrencyWithContext(arg0 aws.Context, arg1 *lambda.DeleteFunctionConcurrencyInput, arg2 ...request.Option) (*lambda.DeleteFunctionConcurrencyOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) DeleteFunctionConcurrencyWithContext(_param0 context.Context, _param1 *lambda.DeleteFunctionConcurrencyInput, _param2 ...request.Option) (*lambda.DeleteFunctionConcurrencyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "DeleteFunctionConcurrencyWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "DeleteFunctionConcurrencyWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.DeleteFunctionConcurrencyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteFunctionConcurrencyWithContext indicates an expected call of DeleteFunctionConcurrencyWithContext
-func (mr *MockLambdaAPIMockRecorder) DeleteFunctionConcurrencyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFunctionConcurrencyWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionConcurrencyWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionConcurrencyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionConcurrencyWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionConcurrencyWithContext), _s...)
+}
+
+// DeleteFunctionEventInvokeConfig mocks base method
+func (_m *MockLambdaAPI) DeleteFunctionEventInvokeConfig(_param0 *lambda.DeleteFunctionEventInvokeConfigInput) (*lambda.DeleteFunctionEventInvokeConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteFunctionEventInvokeConfig", _param0)
+	ret0, _ := ret[0].(*lambda.DeleteFunctionEventInvokeConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFunctionEventInvokeConfig indicates an expected call of DeleteFunctionEventInvokeConfig
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionEventInvokeConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionEventInvokeConfig", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionEventInvokeConfig), arg0)
+}
+
+// DeleteFunctionEventInvokeConfigRequest mocks base method
+func (_m *MockLambdaAPI) DeleteFunctionEventInvokeConfigRequest(_param0 *lambda.DeleteFunctionEventInvokeConfigInput) (*request.Request, *lambda.DeleteFunctionEventInvokeConfigOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteFunctionEventInvokeConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.DeleteFunctionEventInvokeConfigOutput)
+	return ret0, ret1
+}
+
+// DeleteFunctionEventInvokeConfigRequest indicates an expected call of DeleteFunctionEventInvokeConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionEventInvokeConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionEventInvokeConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionEventInvokeConfigRequest), arg0)
+}
+
+// DeleteFunctionEventInvokeConfigWithContext mocks base method
+func (_m *MockLambdaAPI) DeleteFunctionEventInvokeConfigWithContext(_param0 context.Context, _param1 *lambda.DeleteFunctionEventInvokeConfigInput, _param2 ...request.Option) (*lambda.DeleteFunctionEventInvokeConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteFunctionEventInvokeConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.DeleteFunctionEventInvokeConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFunctionEventInvokeConfigWithContext indicates an expected call of DeleteFunctionEventInvokeConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionEventInvokeConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionEventInvokeConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionEventInvokeConfigWithContext), _s...)
 }
 
 // DeleteFunctionRequest mocks base method
-func (m *MockLambdaAPI) DeleteFunctionRequest(arg0 *lambda.DeleteFunctionInput) (*request.Request, *lambda.DeleteFunctionOutput) {
-	ret := m.ctrl.Call(m, "DeleteFunctionRequest", arg0)
+func (_m *MockLambdaAPI) DeleteFunctionRequest(_param0 *lambda.DeleteFunctionInput) (*request.Request, *lambda.DeleteFunctionOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteFunctionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.DeleteFunctionOutput)
 	return ret0, ret1
 }
 
 // DeleteFunctionRequest indicates an expected call of DeleteFunctionRequest
-func (mr *MockLambdaAPIMockRecorder) DeleteFunctionRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFunctionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionRequest), arg0)
+}
+
+// DeleteFunctionUrlConfig mocks base method
+func (_m *MockLambdaAPI) DeleteFunctionUrlConfig(_param0 *lambda.DeleteFunctionUrlConfigInput) (*lambda.DeleteFunctionUrlConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteFunctionUrlConfig", _param0)
+	ret0, _ := ret[0].(*lambda.DeleteFunctionUrlConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFunctionUrlConfig indicates an expected call of DeleteFunctionUrlConfig
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionUrlConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionUrlConfig", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionUrlConfig), arg0)
+}
+
+// DeleteFunctionUrlConfigRequest mocks base method
+func (_m *MockLambdaAPI) DeleteFunctionUrlConfigRequest(_param0 *lambda.DeleteFunctionUrlConfigInput) (*request.Request, *lambda.DeleteFunctionUrlConfigOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteFunctionUrlConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.DeleteFunctionUrlConfigOutput)
+	return ret0, ret1
+}
+
+// DeleteFunctionUrlConfigRequest indicates an expected call of DeleteFunctionUrlConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionUrlConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionUrlConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionUrlConfigRequest), arg0)
+}
+
+// DeleteFunctionUrlConfigWithContext mocks base method
+func (_m *MockLambdaAPI) DeleteFunctionUrlConfigWithContext(_param0 context.Context, _param1 *lambda.DeleteFunctionUrlConfigInput, _param2 ...request.Option) (*lambda.DeleteFunctionUrlConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteFunctionUrlConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.DeleteFunctionUrlConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFunctionUrlConfigWithContext indicates an expected call of DeleteFunctionUrlConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionUrlConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionUrlConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionUrlConfigWithContext), _s...)
 }
 
 // DeleteFunctionWithContext mocks base method
-func (m *MockLambdaAPI) DeleteFunctionWithContext(arg0 aws.Context, arg1 *lambda.DeleteFunctionInput, arg2 ...request.Option) (*lambda.DeleteFunctionOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) DeleteFunctionWithContext(_param0 context.Context, _param1 *lambda.DeleteFunctionInput, _param2 ...request.Option) (*lambda.DeleteFunctionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "DeleteFunctionWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "DeleteFunctionWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.DeleteFunctionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteFunctionWithContext indicates an expected call of DeleteFunctionWithContext
-func (mr *MockLambdaAPIMockRecorder) DeleteFunctionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFunctionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) DeleteFunctionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteFunctionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteFunctionWithContext), _s...)
+}
+
+// DeleteLayerVersion mocks base method
+func (_m *MockLambdaAPI) DeleteLayerVersion(_param0 *lambda.DeleteLayerVersionInput) (*lambda.DeleteLayerVersionOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteLayerVersion", _param0)
+	ret0, _ := ret[0].(*lambda.DeleteLayerVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLayerVersion indicates an expected call of DeleteLayerVersion
+func (_mr *MockLambdaAPIMockRecorder) DeleteLayerVersion(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteLayerVersion", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteLayerVersion), arg0)
+}
+
+// DeleteLayerVersionRequest mocks base method
+func (_m *MockLambdaAPI) DeleteLayerVersionRequest(_param0 *lambda.DeleteLayerVersionInput) (*request.Request, *lambda.DeleteLayerVersionOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteLayerVersionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.DeleteLayerVersionOutput)
+	return ret0, ret1
+}
+
+// DeleteLayerVersionRequest indicates an expected call of DeleteLayerVersionRequest
+func (_mr *MockLambdaAPIMockRecorder) DeleteLayerVersionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteLayerVersionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteLayerVersionRequest), arg0)
+}
+
+// DeleteLayerVersionWithContext mocks base method
+func (_m *MockLambdaAPI) DeleteLayerVersionWithContext(_param0 context.Context, _param1 *lambda.DeleteLayerVersionInput, _param2 ...request.Option) (*lambda.DeleteLayerVersionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteLayerVersionWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.DeleteLayerVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLayerVersionWithContext indicates an expected call of DeleteLayerVersionWithContext
+func (_mr *MockLambdaAPIMockRecorder) DeleteLayerVersionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteLayerVersionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteLayerVersionWithContext), _s...)
+}
+
+// DeleteProvisionedConcurrencyConfig mocks base method
+func (_m *MockLambdaAPI) DeleteProvisionedConcurrencyConfig(_param0 *lambda.DeleteProvisionedConcurrencyConfigInput) (*lambda.DeleteProvisionedConcurrencyConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "DeleteProvisionedConcurrencyConfig", _param0)
+	ret0, _ := ret[0].(*lambda.DeleteProvisionedConcurrencyConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProvisionedConcurrencyConfig indicates an expected call of DeleteProvisionedConcurrencyConfig
+func (_mr *MockLambdaAPIMockRecorder) DeleteProvisionedConcurrencyConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteProvisionedConcurrencyConfig", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteProvisionedConcurrencyConfig), arg0)
+}
+
+// DeleteProvisionedConcurrencyConfigRequest mocks base method
+func (_m *MockLambdaAPI) DeleteProvisionedConcurrencyConfigRequest(_param0 *lambda.DeleteProvisionedConcurrencyConfigInput) (*request.Request, *lambda.DeleteProvisionedConcurrencyConfigOutput) {
+	ret := _m.ctrl.Call(_m, "DeleteProvisionedConcurrencyConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.DeleteProvisionedConcurrencyConfigOutput)
+	return ret0, ret1
+}
+
+// DeleteProvisionedConcurrencyConfigRequest indicates an expected call of DeleteProvisionedConcurrencyConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) DeleteProvisionedConcurrencyConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteProvisionedConcurrencyConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteProvisionedConcurrencyConfigRequest), arg0)
+}
+
+// DeleteProvisionedConcurrencyConfigWithContext mocks base method
+func (_m *MockLambdaAPI) DeleteProvisionedConcurrencyConfigWithContext(_param0 context.Context, _param1 *lambda.DeleteProvisionedConcurrencyConfigInput, _param2 ...request.Option) (*lambda.DeleteProvisionedConcurrencyConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "DeleteProvisionedConcurrencyConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.DeleteProvisionedConcurrencyConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProvisionedConcurrencyConfigWithContext indicates an expected call of DeleteProvisionedConcurrencyConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) DeleteProvisionedConcurrencyConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "DeleteProvisionedConcurrencyConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).DeleteProvisionedConcurrencyConfigWithContext), _s...)
 }
 
 // GetAccountSettings mocks base method
-func (m *MockLambdaAPI) GetAccountSettings(arg0 *lambda.GetAccountSettingsInput) (*lambda.GetAccountSettingsOutput, error) {
-	ret := m.ctrl.Call(m, "GetAccountSettings", arg0)
+func (_m *MockLambdaAPI) GetAccountSettings(_param0 *lambda.GetAccountSettingsInput) (*lambda.GetAccountSettingsOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetAccountSettings", _param0)
 	ret0, _ := ret[0].(*lambda.GetAccountSettingsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAccountSettings indicates an expected call of GetAccountSettings
-func (mr *MockLambdaAPIMockRecorder) GetAccountSettings(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSettings", reflect.TypeOf((*MockLambdaAPI)(nil).GetAccountSettings), arg0)
+func (_mr *MockLambdaAPIMockRecorder) GetAccountSettings(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetAccountSettings", reflect.TypeOf((*MockLambdaAPI)(nil).GetAccountSettings), arg0)
 }
 
 // GetAccountSettingsRequest mocks base method
-func (m *MockLambdaAPI) GetAccountSettingsRequest(arg0 *lambda.GetAccountSettingsInput) (*request.Request, *lambda.GetAccountSettingsOutput) {
-	ret := m.ctrl.Call(m, "GetAccountSettingsRequest", arg0)
+func (_m *MockLambdaAPI) GetAccountSettingsRequest(_param0 *lambda.GetAccountSettingsInput) (*request.Request, *lambda.GetAccountSettingsOutput) {
+	ret := _m.ctrl.Call(_m, "GetAccountSettingsRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.GetAccountSettingsOutput)
 	return ret0, ret1
 }
 
 // GetAccountSettingsRequest indicates an expected call of GetAccountSettingsRequest
-func (mr *MockLambdaAPIMockRecorder) GetAccountSettingsRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSettingsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetAccountSettingsRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) GetAccountSettingsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetAccountSettingsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetAccountSettingsRequest), arg0)
 }
 
 // GetAccountSettingsWithContext mocks base method
-func (m *MockLambdaAPI) GetAccountSettingsWithContext(arg0 aws.Context, arg1 *lambda.GetAccountSettingsInput, arg2 ...request.Option) (*lambda.GetAccountSettingsOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) GetAccountSettingsWithContext(_param0 context.Context, _param1 *lambda.GetAccountSettingsInput, _param2 ...request.Option) (*lambda.GetAccountSettingsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "GetAccountSettingsWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "GetAccountSettingsWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.GetAccountSettingsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAccountSettingsWithContext indicates an expected call of GetAccountSettingsWithContext
-func (mr *MockLambdaAPIMockRecorder) GetAccountSettingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSettingsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetAccountSettingsWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) GetAccountSettingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetAccountSettingsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetAccountSettingsWithContext), _s...)
 }
 
 // GetAlias mocks base method
-func (m *MockLambdaAPI) GetAlias(arg0 *lambda.GetAliasInput) (*lambda.AliasConfiguration, error) {
-	ret := m.ctrl.Call(m, "GetAlias", arg0)
+func (_m *MockLambdaAPI) GetAlias(_param0 *lambda.GetAliasInput) (*lambda.AliasConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "GetAlias", _param0)
 	ret0, _ := ret[0].(*lambda.AliasConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAlias indicates an expected call of GetAlias
-func (mr *MockLambdaAPIMockRecorder) GetAlias(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlias", reflect.TypeOf((*MockLambdaAPI)(nil).GetAlias), arg0)
+func (_mr *MockLambdaAPIMockRecorder) GetAlias(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetAlias", reflect.TypeOf((*MockLambdaAPI)(nil).GetAlias), arg0)
 }
 
 // GetAliasRequest mocks base method
-func (m *MockLambdaAPI) GetAliasRequest(arg0 *lambda.GetAliasInput) (*request.Request, *lambda.AliasConfiguration) {
-	ret := m.ctrl.Call(m, "GetAliasRequest", arg0)
+func (_m *MockLambdaAPI) GetAliasRequest(_param0 *lambda.GetAliasInput) (*request.Request, *lambda.AliasConfiguration) {
+	ret := _m.ctrl.Call(_m, "GetAliasRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.AliasConfiguration)
 	return ret0, ret1
 }
 
 // GetAliasRequest indicates an expected call of GetAliasRequest
-func (mr *MockLambdaAPIMockRecorder) GetAliasRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAliasRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetAliasRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) GetAliasRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetAliasRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetAliasRequest), arg0)
 }
 
 // GetAliasWithContext mocks base method
-func (m *MockLambdaAPI) GetAliasWithContext(arg0 aws.Context, arg1 *lambda.GetAliasInput, arg2 ...request.Option) (*lambda.AliasConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) GetAliasWithContext(_param0 context.Context, _param1 *lambda.GetAliasInput, _param2 ...request.Option) (*lambda.AliasConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "GetAliasWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "GetAliasWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.AliasConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAliasWithContext indicates an expected call of GetAliasWithContext
-func (mr *MockLambdaAPIMockRecorder) GetAliasWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAliasWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetAliasWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) GetAliasWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetAliasWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetAliasWithContext), _s...)
+}
+
+// GetCodeSigningConfig mocks base method
+func (_m *MockLambdaAPI) GetCodeSigningConfig(_param0 *lambda.GetCodeSigningConfigInput) (*lambda.GetCodeSigningConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetCodeSigningConfig", _param0)
+	ret0, _ := ret[0].(*lambda.GetCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeSigningConfig indicates an expected call of GetCodeSigningConfig
+func (_mr *MockLambdaAPIMockRecorder) GetCodeSigningConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetCodeSigningConfig", reflect.TypeOf((*MockLambdaAPI)(nil).GetCodeSigningConfig), arg0)
+}
+
+// GetCodeSigningConfigRequest mocks base method
+func (_m *MockLambdaAPI) GetCodeSigningConfigRequest(_param0 *lambda.GetCodeSigningConfigInput) (*request.Request, *lambda.GetCodeSigningConfigOutput) {
+	ret := _m.ctrl.Call(_m, "GetCodeSigningConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.GetCodeSigningConfigOutput)
+	return ret0, ret1
+}
+
+// GetCodeSigningConfigRequest indicates an expected call of GetCodeSigningConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) GetCodeSigningConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetCodeSigningConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetCodeSigningConfigRequest), arg0)
+}
+
+// GetCodeSigningConfigWithContext mocks base method
+func (_m *MockLambdaAPI) GetCodeSigningConfigWithContext(_param0 context.Context, _param1 *lambda.GetCodeSigningConfigInput, _param2 ...request.Option) (*lambda.GetCodeSigningConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetCodeSigningConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeSigningConfigWithContext indicates an expected call of GetCodeSigningConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetCodeSigningConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetCodeSigningConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetCodeSigningConfigWithContext), _s...)
 }
 
 // GetEventSourceMapping mocks base method
-func (m *MockLambdaAPI) GetEventSourceMapping(arg0 *lambda.GetEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
-	ret := m.ctrl.Call(m, "GetEventSourceMapping", arg0)
+func (_m *MockLambdaAPI) GetEventSourceMapping(_param0 *lambda.GetEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "GetEventSourceMapping", _param0)
 	ret0, _ := ret[0].(*lambda.EventSourceMappingConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEventSourceMapping indicates an expected call of GetEventSourceMapping
-func (mr *MockLambdaAPIMockRecorder) GetEventSourceMapping(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSourceMapping", reflect.TypeOf((*MockLambdaAPI)(nil).GetEventSourceMapping), arg0)
+func (_mr *MockLambdaAPIMockRecorder) GetEventSourceMapping(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetEventSourceMapping", reflect.TypeOf((*MockLambdaAPI)(nil).GetEventSourceMapping), arg0)
 }
 
 // GetEventSourceMappingRequest mocks base method
-func (m *MockLambdaAPI) GetEventSourceMappingRequest(arg0 *lambda.GetEventSourceMappingInput) (*request.Request, *lambda.EventSourceMappingConfiguration) {
-	ret := m.ctrl.Call(m, "GetEventSourceMappingRequest", arg0)
+func (_m *MockLambdaAPI) GetEventSourceMappingRequest(_param0 *lambda.GetEventSourceMappingInput) (*request.Request, *lambda.EventSourceMappingConfiguration) {
+	ret := _m.ctrl.Call(_m, "GetEventSourceMappingRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.EventSourceMappingConfiguration)
 	return ret0, ret1
 }
 
 // GetEventSourceMappingRequest indicates an expected call of GetEventSourceMappingRequest
-func (mr *MockLambdaAPIMockRecorder) GetEventSourceMappingRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSourceMappingRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetEventSourceMappingRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) GetEventSourceMappingRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetEventSourceMappingRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetEventSourceMappingRequest), arg0)
 }
 
 // GetEventSourceMappingWithContext mocks base method
-func (m *MockLambdaAPI) GetEventSourceMappingWithContext(arg0 aws.Context, arg1 *lambda.GetEventSourceMappingInput, arg2 ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) GetEventSourceMappingWithContext(_param0 context.Context, _param1 *lambda.GetEventSourceMappingInput, _param2 ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "GetEventSourceMappingWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "GetEventSourceMappingWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.EventSourceMappingConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEventSourceMappingWithContext indicates an expected call of GetEventSourceMappingWithContext
-func (mr *MockLambdaAPIMockRecorder) GetEventSourceMappingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSourceMappingWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetEventSourceMappingWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) GetEventSourceMappingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetEventSourceMappingWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetEventSourceMappingWithContext), _s...)
 }
 
 // GetFunction mocks base method
-func (m *MockLambdaAPI) GetFunction(arg0 *lambda.GetFunctionInput) (*lambda.GetFunctionOutput, error) {
-	ret := m.ctrl.Call(m, "GetFunction", arg0)
+func (_m *MockLambdaAPI) GetFunction(_param0 *lambda.GetFunctionInput) (*lambda.GetFunctionOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetFunction", _param0)
 	ret0, _ := ret[0].(*lambda.GetFunctionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFunction indicates an expected call of GetFunction
-func (mr *MockLambdaAPIMockRecorder) GetFunction(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFunction", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunction), arg0)
+func (_mr *MockLambdaAPIMockRecorder) GetFunction(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunction", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunction), arg0)
 }
 
-// GetFunctionConfiguration mocks base method
-func (m *MockLambdaAPI) GetFunctionConfiguration(arg0 *lambda.GetFunctionConfigurationInput) (*lambda.FunctionConfiguration, error) {
-	ret := m.ctrl.Call(m, "GetFunctionConfiguration", arg0)
-	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
+// GetFunctionCodeSigningConfig mocks base method
+func (_m *MockLambdaAPI) GetFunctionCodeSigningConfig(_param0 *lambda.GetFunctionCodeSigningConfigInput) (*lambda.GetFunctionCodeSigningConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetFunctionCodeSigningConfig", _param0)
+	ret0, _ := ret[0].(*lambda.GetFunctionCodeSigningConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFunctionConfiguration indicates an expected call of GetFunctionConfiguration
-func (mr *MockLambdaAPIMockRecorder) GetFunctionConfiguration(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFunctionConfiguration", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionConfiguration), arg0)
+// GetFunctionCodeSigningConfig indicates an expected call of GetFunctionCodeSigningConfig
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionCodeSigningConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionCodeSigningConfig", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionCodeSigningConfig), arg0)
 }
 
-// GetFunctionConfigurationRequest mocks base method
-func (m *MockLambdaAPI) GetFunctionConfigurationRequest(arg0 *lambda.GetFunctionConfigurationInput) (*request.Request, *lambda.FunctionConfiguration) {
-	ret := m.ctrl.Call(m, "GetFunctionConfigurationRequest", arg0)
+// GetFunctionCodeSigningConfigRequest mocks base method
+func (_m *MockLambdaAPI) GetFunctionCodeSigningConfigRequest(_param0 *lambda.GetFunctionCodeSigningConfigInput) (*request.Request, *lambda.GetFunctionCodeSigningConfigOutput) {
+	ret := _m.ctrl.Call(_m, "GetFunctionCodeSigningConfigRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.FunctionConfiguration)
+	ret1, _ := ret[1].(*lambda.GetFunctionCodeSigningConfigOutput)
 	return ret0, ret1
 }
 
-// GetFunctionConfigurationRequest indicates an expected call of GetFunctionConfigurationRequest
-func (mr *MockLambdaAPIMockRecorder) GetFunctionConfigurationRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFunctionConfigurationRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionConfigurationRequest), arg0)
+// GetFunctionCodeSigningConfigRequest indicates an expected call of GetFunctionCodeSigningConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionCodeSigningConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionCodeSigningConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionCodeSigningConfigRequest), arg0)
 }
 
-// GetFunctionConfigurationWithContext mocks base method
-func (m *MockLambdaAPI) GetFunctionConfigurationWithContext(arg0 aws.Context, arg1 *lambda.GetFunctionConfigurationInput, arg2 ...request.Option) (*lambda.FunctionConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+// GetFunctionCodeSigningConfigWithContext mocks base method
+func (_m *MockLambdaAPI) GetFunctionCodeSigningConfigWithContext(_param0 context.Context, _param1 *lambda.GetFunctionCodeSigningConfigInput, _param2 ...request.Option) (*lambda.GetFunctionCodeSigningConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "GetFunctionConfigurationWithContext", varargs...)
-	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
+	ret := _m.ctrl.Call(_m, "GetFunctionCodeSigningConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetFunctionCodeSigningConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFunctionConfigurationWithContext indicates an expected call of GetFunctionConfigurationWithContext
-func (mr *MockLambdaAPIMockRecorder) GetFunctionConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFunctionConfigurationWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionConfigurationWithContext), varargs...)
+// GetFunctionCodeSigningConfigWithContext indicates an expected call of GetFunctionCodeSigningConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionCodeSigningConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionCodeSigningConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionCodeSigningConfigWithContext), _s...)
 }
 
-// GetFunctionRequest mocks base method
-func (m *MockLambdaAPI) GetFunctionRequest(arg0 *lambda.GetFunctionInput) (*request.Request, *lambda.GetFunctionOutput) {
-	ret := m.ctrl.Call(m, "GetFunctionRequest", arg0)
+// GetFunctionConcurrency mocks base method
+func (_m *MockLambdaAPI) GetFunctionConcurrency(_param0 *lambda.GetFunctionConcurrencyInput) (*lambda.GetFunctionConcurrencyOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetFunctionConcurrency", _param0)
+	ret0, _ := ret[0].(*lambda.GetFunctionConcurrencyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFunctionConcurrency indicates an expected call of GetFunctionConcurrency
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionConcurrency(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionConcurrency", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionConcurrency), arg0)
+}
+
+// GetFunctionConcurrencyRequest mocks base method
+func (_m *MockLambdaAPI) GetFunctionConcurrencyRequest(_param0 *lambda.GetFunctionConcurrencyInput) (*request.Request, *lambda.GetFunctionConcurrencyOutput) {
+	ret := _m.ctrl.Call(_m, "GetFunctionConcurrencyRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.GetFunctionOutput)
+	ret1, _ := ret[1].(*lambda.GetFunctionConcurrencyOutput)
 	return ret0, ret1
 }
 
-// GetFunctionRequest indicates an expected call of GetFunctionRequest
-func (mr *MockLambdaAPIMockRecorder) GetFunctionRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFunctionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionRequest), arg0)
+// GetFunctionConcurrencyRequest indicates an expected call of GetFunctionConcurrencyRequest
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionConcurrencyRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionConcurrencyRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionConcurrencyRequest), arg0)
 }
 
-// GetFunctionWithContext mocks base method
-func (m *MockLambdaAPI) GetFunctionWithContext(arg0 aws.Context, arg1 *lambda.GetFunctionInput, arg2 ...request.Option) (*lambda.GetFunctionOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+// GetFunctionConcurrencyWithContext mocks base method
+func (_m *MockLambdaAPI) GetFunctionConcurrencyWithContext(_param0 context.Context, _param1 *lambda.GetFunctionConcurrencyInput, _param2 ...request.Option) (*lambda.GetFunctionConcurrencyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "GetFunctionWithContext", varargs...)
-	ret0, _ := ret[0].(*lambda.GetFunctionOutput)
+	ret := _m.ctrl.Call(_m, "GetFunctionConcurrencyWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetFunctionConcurrencyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFunctionWithContext indicates an expected call of GetFunctionWithContext
-func (mr *MockLambdaAPIMockRecorder) GetFunctionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFunctionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionWithContext), varargs...)
+// GetFunctionConcurrencyWithContext indicates an expected call of GetFunctionConcurrencyWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionConcurrencyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionConcurrencyWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionConcurrencyWithContext), _s...)
 }
 
-// GetPolicy mocks base method
-func (m *MockLambdaAPI) GetPolicy(arg0 *lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error) {
-	ret := m.ctrl.Call(m, "GetPolicy", arg0)
-	ret0, _ := ret[0].(*lambda.GetPolicyOutput)
+// GetFunctionConfiguration mocks base method
+func (_m *MockLambdaAPI) GetFunctionConfiguration(_param0 *lambda.GetFunctionConfigurationInput) (*lambda.FunctionConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "GetFunctionConfiguration", _param0)
+	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPolicy indicates an expected call of GetPolicy
-func (mr *MockLambdaAPIMockRecorder) GetPolicy(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockLambdaAPI)(nil).GetPolicy), arg0)
+// GetFunctionConfiguration indicates an expected call of GetFunctionConfiguration
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionConfiguration(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionConfiguration", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionConfiguration), arg0)
 }
 
-// GetPolicyRequest mocks base method
-func (m *MockLambdaAPI) GetPolicyRequest(arg0 *lambda.GetPolicyInput) (*request.Request, *lambda.GetPolicyOutput) {
-	ret := m.ctrl.Call(m, "GetPolicyRequest", arg0)
+// GetFunctionConfigurationRequest mocks base method
+func (_m *MockLambdaAPI) GetFunctionConfigurationRequest(_param0 *lambda.GetFunctionConfigurationInput) (*request.Request, *lambda.FunctionConfiguration) {
+	ret := _m.ctrl.Call(_m, "GetFunctionConfigurationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.GetPolicyOutput)
+	ret1, _ := ret[1].(*lambda.FunctionConfiguration)
 	return ret0, ret1
 }
 
-// GetPolicyRequest indicates an expected call of GetPolicyRequest
-func (mr *MockLambdaAPIMockRecorder) GetPolicyRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetPolicyRequest), arg0)
+// GetFunctionConfigurationRequest indicates an expected call of GetFunctionConfigurationRequest
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionConfigurationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionConfigurationRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionConfigurationRequest), arg0)
 }
 
-// GetPolicyWithContext mocks base method
-func (m *MockLambdaAPI) GetPolicyWithContext(arg0 aws.Context, arg1 *lambda.GetPolicyInput, arg2 ...request.Option) (*lambda.GetPolicyOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+// GetFunctionConfigurationWithContext mocks base method
+func (_m *MockLambdaAPI) GetFunctionConfigurationWithContext(_param0 context.Context, _param1 *lambda.GetFunctionConfigurationInput, _param2 ...request.Option) (*lambda.FunctionConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "GetPolicyWithContext", varargs...)
-	ret0, _ := ret[0].(*lambda.GetPolicyOutput)
+	ret := _m.ctrl.Call(_m, "GetFunctionConfigurationWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPolicyWithContext indicates an expected call of GetPolicyWithContext
-func (mr *MockLambdaAPIMockRecorder) GetPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicyWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetPolicyWithContext), varargs...)
+// GetFunctionConfigurationWithContext indicates an expected call of GetFunctionConfigurationWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionConfigurationWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionConfigurationWithContext), _s...)
 }
 
-// Invoke mocks base method
-func (m *MockLambdaAPI) Invoke(arg0 *lambda.InvokeInput) (*lambda.InvokeOutput, error) {
-	ret := m.ctrl.Call(m, "Invoke", arg0)
-	ret0, _ := ret[0].(*lambda.InvokeOutput)
+// GetFunctionEventInvokeConfig mocks base method
+func (_m *MockLambdaAPI) GetFunctionEventInvokeConfig(_param0 *lambda.GetFunctionEventInvokeConfigInput) (*lambda.GetFunctionEventInvokeConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetFunctionEventInvokeConfig", _param0)
+	ret0, _ := ret[0].(*lambda.GetFunctionEventInvokeConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Invoke indicates an expected call of Invoke
-func (mr *MockLambdaAPIMockRecorder) Invoke(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invoke", reflect.TypeOf((*MockLambdaAPI)(nil).Invoke), arg0)
+// GetFunctionEventInvokeConfig indicates an expected call of GetFunctionEventInvokeConfig
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionEventInvokeConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionEventInvokeConfig", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionEventInvokeConfig), arg0)
 }
 
-// InvokeAsync mocks base method
-func (m *MockLambdaAPI) InvokeAsync(arg0 *lambda.InvokeAsyncInput) (*lambda.InvokeAsyncOutput, error) {
-	ret := m.ctrl.Call(m, "InvokeAsync", arg0)
-	ret0, _ := ret[0].(*lambda.InvokeAsyncOutput)
+// GetFunctionEventInvokeConfigRequest mocks base method
+func (_m *MockLambdaAPI) GetFunctionEventInvokeConfigRequest(_param0 *lambda.GetFunctionEventInvokeConfigInput) (*request.Request, *lambda.GetFunctionEventInvokeConfigOutput) {
+	ret := _m.ctrl.Call(_m, "GetFunctionEventInvokeConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.GetFunctionEventInvokeConfigOutput)
+	return ret0, ret1
+}
+
+// GetFunctionEventInvokeConfigRequest indicates an expected call of GetFunctionEventInvokeConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionEventInvokeConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionEventInvokeConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionEventInvokeConfigRequest), arg0)
+}
+
+// GetFunctionEventInvokeConfigWithContext mocks base method
+func (_m *MockLambdaAPI) GetFunctionEventInvokeConfigWithContext(_param0 context.Context, _param1 *lambda.GetFunctionEventInvokeConfigInput, _param2 ...request.Option) (*lambda.GetFunctionEventInvokeConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetFunctionEventInvokeConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetFunctionEventInvokeConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// InvokeAsync indicates an expected call of InvokeAsync
-func (mr *MockLambdaAPIMockRecorder) InvokeAsync(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeAsync", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeAsync), arg0)
+// GetFunctionEventInvokeConfigWithContext indicates an expected call of GetFunctionEventInvokeConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionEventInvokeConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionEventInvokeConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionEventInvokeConfigWithContext), _s...)
 }
 
-// InvokeAsyncRequest mocks base method
-func (m *MockLambdaAPI) InvokeAsyncRequest(arg0 *lambda.InvokeAsyncInput) (*request.Request, *lambda.InvokeAsyncOutput) {
-	ret := m.ctrl.Call(m, "InvokeAsyncRequest", arg0)
+// GetFunctionRequest mocks base method
+func (_m *MockLambdaAPI) GetFunctionRequest(_param0 *lambda.GetFunctionInput) (*request.Request, *lambda.GetFunctionOutput) {
+	ret := _m.ctrl.Call(_m, "GetFunctionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.InvokeAsyncOutput)
+	ret1, _ := ret[1].(*lambda.GetFunctionOutput)
 	return ret0, ret1
 }
 
-// InvokeAsyncRequest indicates an expected call of InvokeAsyncRequest
-func (mr *MockLambdaAPIMockRecorder) InvokeAsyncRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeAsyncRequest", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeAsyncRequest), arg0)
+// GetFunctionRequest indicates an expected call of GetFunctionRequest
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionRequest), arg0)
 }
 
-// InvokeAsyncWithContext mocks base method
-func (m *MockLambdaAPI) InvokeAsyncWithContext(arg0 aws.Context, arg1 *lambda.InvokeAsyncInput, arg2 ...request.Option) (*lambda.InvokeAsyncOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "InvokeAsyncWithContext", varargs...)
-	ret0, _ := ret[0].(*lambda.InvokeAsyncOutput)
+// GetFunctionUrlConfig mocks base method
+func (_m *MockLambdaAPI) GetFunctionUrlConfig(_param0 *lambda.GetFunctionUrlConfigInput) (*lambda.GetFunctionUrlConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetFunctionUrlConfig", _param0)
+	ret0, _ := ret[0].(*lambda.GetFunctionUrlConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// InvokeAsyncWithContext indicates an expected call of InvokeAsyncWithContext
-func (mr *MockLambdaAPIMockRecorder) InvokeAsyncWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeAsyncWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeAsyncWithContext), varargs...)
+// GetFunctionUrlConfig indicates an expected call of GetFunctionUrlConfig
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionUrlConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionUrlConfig", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionUrlConfig), arg0)
 }
 
-// InvokeRequest mocks base method
-func (m *MockLambdaAPI) InvokeRequest(arg0 *lambda.InvokeInput) (*request.Request, *lambda.InvokeOutput) {
-	ret := m.ctrl.Call(m, "InvokeRequest", arg0)
+// GetFunctionUrlConfigRequest mocks base method
+func (_m *MockLambdaAPI) GetFunctionUrlConfigRequest(_param0 *lambda.GetFunctionUrlConfigInput) (*request.Request, *lambda.GetFunctionUrlConfigOutput) {
+	ret := _m.ctrl.Call(_m, "GetFunctionUrlConfigRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.InvokeOutput)
+	ret1, _ := ret[1].(*lambda.GetFunctionUrlConfigOutput)
 	return ret0, ret1
 }
 
-// InvokeRequest indicates an expected call of InvokeRequest
-func (mr *MockLambdaAPIMockRecorder) InvokeRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeRequest", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeRequest), arg0)
+// GetFunctionUrlConfigRequest indicates an expected call of GetFunctionUrlConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionUrlConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionUrlConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionUrlConfigRequest), arg0)
 }
 
-// InvokeWithContext mocks base method
-func (m *MockLambdaAPI) InvokeWithContext(arg0 aws.Context, arg1 *lambda.InvokeInput, arg2 ...request.Option) (*lambda.InvokeOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+// GetFunctionUrlConfigWithContext mocks base method
+func (_m *MockLambdaAPI) GetFunctionUrlConfigWithContext(_param0 context.Context, _param1 *lambda.GetFunctionUrlConfigInput, _param2 ...request.Option) (*lambda.GetFunctionUrlConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "InvokeWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "GetFunctionUrlConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetFunctionUrlConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFunctionUrlConfigWithContext indicates an expected call of GetFunctionUrlConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionUrlConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionUrlConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionUrlConfigWithContext), _s...)
+}
+
+// GetFunctionWithContext mocks base method
+func (_m *MockLambdaAPI) GetFunctionWithContext(_param0 context.Context, _param1 *lambda.GetFunctionInput, _param2 ...request.Option) (*lambda.GetFunctionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetFunctionWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetFunctionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFunctionWithContext indicates an expected call of GetFunctionWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetFunctionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetFunctionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetFunctionWithContext), _s...)
+}
+
+// GetLayerVersion mocks base method
+func (_m *MockLambdaAPI) GetLayerVersion(_param0 *lambda.GetLayerVersionInput) (*lambda.GetLayerVersionOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetLayerVersion", _param0)
+	ret0, _ := ret[0].(*lambda.GetLayerVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLayerVersion indicates an expected call of GetLayerVersion
+func (_mr *MockLambdaAPIMockRecorder) GetLayerVersion(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetLayerVersion", reflect.TypeOf((*MockLambdaAPI)(nil).GetLayerVersion), arg0)
+}
+
+// GetLayerVersionByArn mocks base method
+func (_m *MockLambdaAPI) GetLayerVersionByArn(_param0 *lambda.GetLayerVersionByArnInput) (*lambda.GetLayerVersionByArnOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetLayerVersionByArn", _param0)
+	ret0, _ := ret[0].(*lambda.GetLayerVersionByArnOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLayerVersionByArn indicates an expected call of GetLayerVersionByArn
+func (_mr *MockLambdaAPIMockRecorder) GetLayerVersionByArn(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetLayerVersionByArn", reflect.TypeOf((*MockLambdaAPI)(nil).GetLayerVersionByArn), arg0)
+}
+
+// GetLayerVersionByArnRequest mocks base method
+func (_m *MockLambdaAPI) GetLayerVersionByArnRequest(_param0 *lambda.GetLayerVersionByArnInput) (*request.Request, *lambda.GetLayerVersionByArnOutput) {
+	ret := _m.ctrl.Call(_m, "GetLayerVersionByArnRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.GetLayerVersionByArnOutput)
+	return ret0, ret1
+}
+
+// GetLayerVersionByArnRequest indicates an expected call of GetLayerVersionByArnRequest
+func (_mr *MockLambdaAPIMockRecorder) GetLayerVersionByArnRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetLayerVersionByArnRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetLayerVersionByArnRequest), arg0)
+}
+
+// GetLayerVersionByArnWithContext mocks base method
+func (_m *MockLambdaAPI) GetLayerVersionByArnWithContext(_param0 context.Context, _param1 *lambda.GetLayerVersionByArnInput, _param2 ...request.Option) (*lambda.GetLayerVersionByArnOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetLayerVersionByArnWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetLayerVersionByArnOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLayerVersionByArnWithContext indicates an expected call of GetLayerVersionByArnWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetLayerVersionByArnWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetLayerVersionByArnWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetLayerVersionByArnWithContext), _s...)
+}
+
+// GetLayerVersionPolicy mocks base method
+func (_m *MockLambdaAPI) GetLayerVersionPolicy(_param0 *lambda.GetLayerVersionPolicyInput) (*lambda.GetLayerVersionPolicyOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetLayerVersionPolicy", _param0)
+	ret0, _ := ret[0].(*lambda.GetLayerVersionPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLayerVersionPolicy indicates an expected call of GetLayerVersionPolicy
+func (_mr *MockLambdaAPIMockRecorder) GetLayerVersionPolicy(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetLayerVersionPolicy", reflect.TypeOf((*MockLambdaAPI)(nil).GetLayerVersionPolicy), arg0)
+}
+
+// GetLayerVersionPolicyRequest mocks base method
+func (_m *MockLambdaAPI) GetLayerVersionPolicyRequest(_param0 *lambda.GetLayerVersionPolicyInput) (*request.Request, *lambda.GetLayerVersionPolicyOutput) {
+	ret := _m.ctrl.Call(_m, "GetLayerVersionPolicyRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.GetLayerVersionPolicyOutput)
+	return ret0, ret1
+}
+
+// GetLayerVersionPolicyRequest indicates an expected call of GetLayerVersionPolicyRequest
+func (_mr *MockLambdaAPIMockRecorder) GetLayerVersionPolicyRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetLayerVersionPolicyRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetLayerVersionPolicyRequest), arg0)
+}
+
+// GetLayerVersionPolicyWithContext mocks base method
+func (_m *MockLambdaAPI) GetLayerVersionPolicyWithContext(_param0 context.Context, _param1 *lambda.GetLayerVersionPolicyInput, _param2 ...request.Option) (*lambda.GetLayerVersionPolicyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetLayerVersionPolicyWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetLayerVersionPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLayerVersionPolicyWithContext indicates an expected call of GetLayerVersionPolicyWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetLayerVersionPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetLayerVersionPolicyWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetLayerVersionPolicyWithContext), _s...)
+}
+
+// GetLayerVersionRequest mocks base method
+func (_m *MockLambdaAPI) GetLayerVersionRequest(_param0 *lambda.GetLayerVersionInput) (*request.Request, *lambda.GetLayerVersionOutput) {
+	ret := _m.ctrl.Call(_m, "GetLayerVersionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.GetLayerVersionOutput)
+	return ret0, ret1
+}
+
+// GetLayerVersionRequest indicates an expected call of GetLayerVersionRequest
+func (_mr *MockLambdaAPIMockRecorder) GetLayerVersionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetLayerVersionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetLayerVersionRequest), arg0)
+}
+
+// GetLayerVersionWithContext mocks base method
+func (_m *MockLambdaAPI) GetLayerVersionWithContext(_param0 context.Context, _param1 *lambda.GetLayerVersionInput, _param2 ...request.Option) (*lambda.GetLayerVersionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetLayerVersionWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetLayerVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLayerVersionWithContext indicates an expected call of GetLayerVersionWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetLayerVersionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetLayerVersionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetLayerVersionWithContext), _s...)
+}
+
+// GetPolicy mocks base method
+func (_m *MockLambdaAPI) GetPolicy(_param0 *lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetPolicy", _param0)
+	ret0, _ := ret[0].(*lambda.GetPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicy indicates an expected call of GetPolicy
+func (_mr *MockLambdaAPIMockRecorder) GetPolicy(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetPolicy", reflect.TypeOf((*MockLambdaAPI)(nil).GetPolicy), arg0)
+}
+
+// GetPolicyRequest mocks base method
+func (_m *MockLambdaAPI) GetPolicyRequest(_param0 *lambda.GetPolicyInput) (*request.Request, *lambda.GetPolicyOutput) {
+	ret := _m.ctrl.Call(_m, "GetPolicyRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.GetPolicyOutput)
+	return ret0, ret1
+}
+
+// GetPolicyRequest indicates an expected call of GetPolicyRequest
+func (_mr *MockLambdaAPIMockRecorder) GetPolicyRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetPolicyRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetPolicyRequest), arg0)
+}
+
+// GetPolicyWithContext mocks base method
+func (_m *MockLambdaAPI) GetPolicyWithContext(_param0 context.Context, _param1 *lambda.GetPolicyInput, _param2 ...request.Option) (*lambda.GetPolicyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetPolicyWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicyWithContext indicates an expected call of GetPolicyWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetPolicyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetPolicyWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetPolicyWithContext), _s...)
+}
+
+// GetProvisionedConcurrencyConfig mocks base method
+func (_m *MockLambdaAPI) GetProvisionedConcurrencyConfig(_param0 *lambda.GetProvisionedConcurrencyConfigInput) (*lambda.GetProvisionedConcurrencyConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetProvisionedConcurrencyConfig", _param0)
+	ret0, _ := ret[0].(*lambda.GetProvisionedConcurrencyConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvisionedConcurrencyConfig indicates an expected call of GetProvisionedConcurrencyConfig
+func (_mr *MockLambdaAPIMockRecorder) GetProvisionedConcurrencyConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetProvisionedConcurrencyConfig", reflect.TypeOf((*MockLambdaAPI)(nil).GetProvisionedConcurrencyConfig), arg0)
+}
+
+// GetProvisionedConcurrencyConfigRequest mocks base method
+func (_m *MockLambdaAPI) GetProvisionedConcurrencyConfigRequest(_param0 *lambda.GetProvisionedConcurrencyConfigInput) (*request.Request, *lambda.GetProvisionedConcurrencyConfigOutput) {
+	ret := _m.ctrl.Call(_m, "GetProvisionedConcurrencyConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.GetProvisionedConcurrencyConfigOutput)
+	return ret0, ret1
+}
+
+// GetProvisionedConcurrencyConfigRequest indicates an expected call of GetProvisionedConcurrencyConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) GetProvisionedConcurrencyConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetProvisionedConcurrencyConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetProvisionedConcurrencyConfigRequest), arg0)
+}
+
+// GetProvisionedConcurrencyConfigWithContext mocks base method
+func (_m *MockLambdaAPI) GetProvisionedConcurrencyConfigWithContext(_param0 context.Context, _param1 *lambda.GetProvisionedConcurrencyConfigInput, _param2 ...request.Option) (*lambda.GetProvisionedConcurrencyConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetProvisionedConcurrencyConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetProvisionedConcurrencyConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProvisionedConcurrencyConfigWithContext indicates an expected call of GetProvisionedConcurrencyConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetProvisionedConcurrencyConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetProvisionedConcurrencyConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetProvisionedConcurrencyConfigWithContext), _s...)
+}
+
+// GetRuntimeManagementConfig mocks base method
+func (_m *MockLambdaAPI) GetRuntimeManagementConfig(_param0 *lambda.GetRuntimeManagementConfigInput) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "GetRuntimeManagementConfig", _param0)
+	ret0, _ := ret[0].(*lambda.GetRuntimeManagementConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuntimeManagementConfig indicates an expected call of GetRuntimeManagementConfig
+func (_mr *MockLambdaAPIMockRecorder) GetRuntimeManagementConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetRuntimeManagementConfig", reflect.TypeOf((*MockLambdaAPI)(nil).GetRuntimeManagementConfig), arg0)
+}
+
+// GetRuntimeManagementConfigRequest mocks base method
+func (_m *MockLambdaAPI) GetRuntimeManagementConfigRequest(_param0 *lambda.GetRuntimeManagementConfigInput) (*request.Request, *lambda.GetRuntimeManagementConfigOutput) {
+	ret := _m.ctrl.Call(_m, "GetRuntimeManagementConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.GetRuntimeManagementConfigOutput)
+	return ret0, ret1
+}
+
+// GetRuntimeManagementConfigRequest indicates an expected call of GetRuntimeManagementConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) GetRuntimeManagementConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetRuntimeManagementConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).GetRuntimeManagementConfigRequest), arg0)
+}
+
+// GetRuntimeManagementConfigWithContext mocks base method
+func (_m *MockLambdaAPI) GetRuntimeManagementConfigWithContext(_param0 context.Context, _param1 *lambda.GetRuntimeManagementConfigInput, _param2 ...request.Option) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetRuntimeManagementConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.GetRuntimeManagementConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuntimeManagementConfigWithContext indicates an expected call of GetRuntimeManagementConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) GetRuntimeManagementConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "GetRuntimeManagementConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).GetRuntimeManagementConfigWithContext), _s...)
+}
+
+// Invoke mocks base method
+func (_m *MockLambdaAPI) Invoke(_param0 *lambda.InvokeInput) (*lambda.InvokeOutput, error) {
+	ret := _m.ctrl.Call(_m, "Invoke", _param0)
+	ret0, _ := ret[0].(*lambda.InvokeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Invoke indicates an expected call of Invoke
+func (_mr *MockLambdaAPIMockRecorder) Invoke(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "Invoke", reflect.TypeOf((*MockLambdaAPI)(nil).Invoke), arg0)
+}
+
+// InvokeAsync mocks base method
+func (_m *MockLambdaAPI) InvokeAsync(_param0 *lambda.InvokeAsyncInput) (*lambda.InvokeAsyncOutput, error) {
+	ret := _m.ctrl.Call(_m, "InvokeAsync", _param0)
+	ret0, _ := ret[0].(*lambda.InvokeAsyncOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvokeAsync indicates an expected call of InvokeAsync
+func (_mr *MockLambdaAPIMockRecorder) InvokeAsync(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "InvokeAsync", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeAsync), arg0)
+}
+
+// InvokeAsyncRequest mocks base method
+func (_m *MockLambdaAPI) InvokeAsyncRequest(_param0 *lambda.InvokeAsyncInput) (*request.Request, *lambda.InvokeAsyncOutput) {
+	ret := _m.ctrl.Call(_m, "InvokeAsyncRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.InvokeAsyncOutput)
+	return ret0, ret1
+}
+
+// InvokeAsyncRequest indicates an expected call of InvokeAsyncRequest
+func (_mr *MockLambdaAPIMockRecorder) InvokeAsyncRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "InvokeAsyncRequest", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeAsyncRequest), arg0)
+}
+
+// InvokeAsyncWithContext mocks base method
+func (_m *MockLambdaAPI) InvokeAsyncWithContext(_param0 context.Context, _param1 *lambda.InvokeAsyncInput, _param2 ...request.Option) (*lambda.InvokeAsyncOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "InvokeAsyncWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.InvokeAsyncOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvokeAsyncWithContext indicates an expected call of InvokeAsyncWithContext
+func (_mr *MockLambdaAPIMockRecorder) InvokeAsyncWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "InvokeAsyncWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeAsyncWithContext), _s...)
+}
+
+// InvokeRequest mocks base method
+func (_m *MockLambdaAPI) InvokeRequest(_param0 *lambda.InvokeInput) (*request.Request, *lambda.InvokeOutput) {
+	ret := _m.ctrl.Call(_m, "InvokeRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.InvokeOutput)
+	return ret0, ret1
+}
+
+// InvokeRequest indicates an expected call of InvokeRequest
+func (_mr *MockLambdaAPIMockRecorder) InvokeRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "InvokeRequest", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeRequest), arg0)
+}
+
+// InvokeWithContext mocks base method
+func (_m *MockLambdaAPI) InvokeWithContext(_param0 context.Context, _param1 *lambda.InvokeInput, _param2 ...request.Option) (*lambda.InvokeOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "InvokeWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.InvokeOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // InvokeWithContext indicates an expected call of InvokeWithContext
-func (mr *MockLambdaAPIMockRecorder) InvokeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) InvokeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "InvokeWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeWithContext), _s...)
+}
+
+// InvokeWithResponseStream mocks base method
+func (_m *MockLambdaAPI) InvokeWithResponseStream(_param0 *lambda.InvokeWithResponseStreamInput) (*lambda.InvokeWithResponseStreamOutput, error) {
+	ret := _m.ctrl.Call(_m, "InvokeWithResponseStream", _param0)
+	ret0, _ := ret[0].(*lambda.InvokeWithResponseStreamOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvokeWithResponseStream indicates an expected call of InvokeWithResponseStream
+func (_mr *MockLambdaAPIMockRecorder) InvokeWithResponseStream(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "InvokeWithResponseStream", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeWithResponseStream), arg0)
+}
+
+// InvokeWithResponseStreamRequest mocks base method
+func (_m *MockLambdaAPI) InvokeWithResponseStreamRequest(_param0 *lambda.InvokeWithResponseStreamInput) (*request.Request, *lambda.InvokeWithResponseStreamOutput) {
+	ret := _m.ctrl.Call(_m, "InvokeWithResponseStreamRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.InvokeWithResponseStreamOutput)
+	return ret0, ret1
+}
+
+// InvokeWithResponseStreamRequest indicates an expected call of InvokeWithResponseStreamRequest
+func (_mr *MockLambdaAPIMockRecorder) InvokeWithResponseStreamRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "InvokeWithResponseStreamRequest", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeWithResponseStreamRequest), arg0)
+}
+
+// InvokeWithResponseStreamWithContext mocks base method
+func (_m *MockLambdaAPI) InvokeWithResponseStreamWithContext(_param0 context.Context, _param1 *lambda.InvokeWithResponseStreamInput, _param2 ...request.Option) (*lambda.InvokeWithResponseStreamOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "InvokeWithResponseStreamWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.InvokeWithResponseStreamOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvokeWithResponseStreamWithContext indicates an expected call of InvokeWithResponseStreamWithContext
+func (_mr *MockLambdaAPIMockRecorder) InvokeWithResponseStreamWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "InvokeWithResponseStreamWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).InvokeWithResponseStreamWithContext), _s...)
 }
 
 // ListAliases mocks base method
-func (m *MockLambdaAPI) ListAliases(arg0 *lambda.ListAliasesInput) (*lambda.ListAliasesOutput, error) {
-	ret := m.ctrl.Call(m, "ListAliases", arg0)
+func (_m *MockLambdaAPI) ListAliases(_param0 *lambda.ListAliasesInput) (*lambda.ListAliasesOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListAliases", _param0)
+	ret0, _ := ret[0].(*lambda.ListAliasesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAliases indicates an expected call of ListAliases
+func (_mr *MockLambdaAPIMockRecorder) ListAliases(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListAliases", reflect.TypeOf((*MockLambdaAPI)(nil).ListAliases), arg0)
+}
+
+// ListAliasesPages mocks base method
+func (_m *MockLambdaAPI) ListAliasesPages(_param0 *lambda.ListAliasesInput, _param1 func(*lambda.ListAliasesOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListAliasesPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAliasesPages indicates an expected call of ListAliasesPages
+func (_mr *MockLambdaAPIMockRecorder) ListAliasesPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListAliasesPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListAliasesPages), arg0, arg1)
+}
+
+// ListAliasesPagesWithContext mocks base method
+func (_m *MockLambdaAPI) ListAliasesPagesWithContext(_param0 context.Context, _param1 *lambda.ListAliasesInput, _param2 func(*lambda.ListAliasesOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListAliasesPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAliasesPagesWithContext indicates an expected call of ListAliasesPagesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListAliasesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListAliasesPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListAliasesPagesWithContext), _s...)
+}
+
+// ListAliasesRequest mocks base method
+func (_m *MockLambdaAPI) ListAliasesRequest(_param0 *lambda.ListAliasesInput) (*request.Request, *lambda.ListAliasesOutput) {
+	ret := _m.ctrl.Call(_m, "ListAliasesRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.ListAliasesOutput)
+	return ret0, ret1
+}
+
+// ListAliasesRequest indicates an expected call of ListAliasesRequest
+func (_mr *MockLambdaAPIMockRecorder) ListAliasesRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListAliasesRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListAliasesRequest), arg0)
+}
+
+// ListAliasesWithContext mocks base method
+func (_m *MockLambdaAPI) ListAliasesWithContext(_param0 context.Context, _param1 *lambda.ListAliasesInput, _param2 ...request.Option) (*lambda.ListAliasesOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListAliasesWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.ListAliasesOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAliases indicates an expected call of ListAliases
-func (mr *MockLambdaAPIMockRecorder) ListAliases(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAliases", reflect.TypeOf((*MockLambdaAPI)(nil).ListAliases), arg0)
+// ListAliasesWithContext indicates an expected call of ListAliasesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListAliasesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListAliasesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListAliasesWithContext), _s...)
+}
+
+// ListCodeSigningConfigs mocks base method
+func (_m *MockLambdaAPI) ListCodeSigningConfigs(_param0 *lambda.ListCodeSigningConfigsInput) (*lambda.ListCodeSigningConfigsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListCodeSigningConfigs", _param0)
+	ret0, _ := ret[0].(*lambda.ListCodeSigningConfigsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCodeSigningConfigs indicates an expected call of ListCodeSigningConfigs
+func (_mr *MockLambdaAPIMockRecorder) ListCodeSigningConfigs(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListCodeSigningConfigs", reflect.TypeOf((*MockLambdaAPI)(nil).ListCodeSigningConfigs), arg0)
+}
+
+// ListCodeSigningConfigsPages mocks base method
+func (_m *MockLambdaAPI) ListCodeSigningConfigsPages(_param0 *lambda.ListCodeSigningConfigsInput, _param1 func(*lambda.ListCodeSigningConfigsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListCodeSigningConfigsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCodeSigningConfigsPages indicates an expected call of ListCodeSigningConfigsPages
+func (_mr *MockLambdaAPIMockRecorder) ListCodeSigningConfigsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListCodeSigningConfigsPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListCodeSigningConfigsPages), arg0, arg1)
+}
+
+// ListCodeSigningConfigsPagesWithContext mocks base method
+func (_m *MockLambdaAPI) ListCodeSigningConfigsPagesWithContext(_param0 context.Context, _param1 *lambda.ListCodeSigningConfigsInput, _param2 func(*lambda.ListCodeSigningConfigsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListCodeSigningConfigsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCodeSigningConfigsPagesWithContext indicates an expected call of ListCodeSigningConfigsPagesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListCodeSigningConfigsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListCodeSigningConfigsPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListCodeSigningConfigsPagesWithContext), _s...)
+}
+
+// ListCodeSigningConfigsRequest mocks base method
+func (_m *MockLambdaAPI) ListCodeSigningConfigsRequest(_param0 *lambda.ListCodeSigningConfigsInput) (*request.Request, *lambda.ListCodeSigningConfigsOutput) {
+	ret := _m.ctrl.Call(_m, "ListCodeSigningConfigsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.ListCodeSigningConfigsOutput)
+	return ret0, ret1
+}
+
+// ListCodeSigningConfigsRequest indicates an expected call of ListCodeSigningConfigsRequest
+func (_mr *MockLambdaAPIMockRecorder) ListCodeSigningConfigsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListCodeSigningConfigsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListCodeSigningConfigsRequest), arg0)
+}
+
+// ListCodeSigningConfigsWithContext mocks base method
+func (_m *MockLambdaAPI) ListCodeSigningConfigsWithContext(_param0 context.Context, _param1 *lambda.ListCodeSigningConfigsInput, _param2 ...request.Option) (*lambda.ListCodeSigningConfigsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListCodeSigningConfigsWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.ListCodeSigningConfigsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCodeSigningConfigsWithContext indicates an expected call of ListCodeSigningConfigsWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListCodeSigningConfigsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListCodeSigningConfigsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListCodeSigningConfigsWithContext), _s...)
+}
+
+// ListEventSourceMappings mocks base method
+func (_m *MockLambdaAPI) ListEventSourceMappings(_param0 *lambda.ListEventSourceMappingsInput) (*lambda.ListEventSourceMappingsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListEventSourceMappings", _param0)
+	ret0, _ := ret[0].(*lambda.ListEventSourceMappingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventSourceMappings indicates an expected call of ListEventSourceMappings
+func (_mr *MockLambdaAPIMockRecorder) ListEventSourceMappings(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListEventSourceMappings", reflect.TypeOf((*MockLambdaAPI)(nil).ListEventSourceMappings), arg0)
+}
+
+// ListEventSourceMappingsPages mocks base method
+func (_m *MockLambdaAPI) ListEventSourceMappingsPages(_param0 *lambda.ListEventSourceMappingsInput, _param1 func(*lambda.ListEventSourceMappingsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListEventSourceMappingsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListEventSourceMappingsPages indicates an expected call of ListEventSourceMappingsPages
+func (_mr *MockLambdaAPIMockRecorder) ListEventSourceMappingsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListEventSourceMappingsPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListEventSourceMappingsPages), arg0, arg1)
+}
+
+// ListEventSourceMappingsPagesWithContext mocks base method
+func (_m *MockLambdaAPI) ListEventSourceMappingsPagesWithContext(_param0 context.Context, _param1 *lambda.ListEventSourceMappingsInput, _param2 func(*lambda.ListEventSourceMappingsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListEventSourceMappingsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListEventSourceMappingsPagesWithContext indicates an expected call of ListEventSourceMappingsPagesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListEventSourceMappingsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListEventSourceMappingsPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListEventSourceMappingsPagesWithContext), _s...)
+}
+
+// ListEventSourceMappingsRequest mocks base method
+func (_m *MockLambdaAPI) ListEventSourceMappingsRequest(_param0 *lambda.ListEventSourceMappingsInput) (*request.Request, *lambda.ListEventSourceMappingsOutput) {
+	ret := _m.ctrl.Call(_m, "ListEventSourceMappingsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.ListEventSourceMappingsOutput)
+	return ret0, ret1
+}
+
+// ListEventSourceMappingsRequest indicates an expected call of ListEventSourceMappingsRequest
+func (_mr *MockLambdaAPIMockRecorder) ListEventSourceMappingsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListEventSourceMappingsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListEventSourceMappingsRequest), arg0)
+}
+
+// ListEventSourceMappingsWithContext mocks base method
+func (_m *MockLambdaAPI) ListEventSourceMappingsWithContext(_param0 context.Context, _param1 *lambda.ListEventSourceMappingsInput, _param2 ...request.Option) (*lambda.ListEventSourceMappingsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListEventSourceMappingsWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.ListEventSourceMappingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventSourceMappingsWithContext indicates an expected call of ListEventSourceMappingsWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListEventSourceMappingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListEventSourceMappingsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListEventSourceMappingsWithContext), _s...)
+}
+
+// ListFunctionEventInvokeConfigs mocks base method
+func (_m *MockLambdaAPI) ListFunctionEventInvokeConfigs(_param0 *lambda.ListFunctionEventInvokeConfigsInput) (*lambda.ListFunctionEventInvokeConfigsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListFunctionEventInvokeConfigs", _param0)
+	ret0, _ := ret[0].(*lambda.ListFunctionEventInvokeConfigsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctionEventInvokeConfigs indicates an expected call of ListFunctionEventInvokeConfigs
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionEventInvokeConfigs(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionEventInvokeConfigs", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionEventInvokeConfigs), arg0)
+}
+
+// ListFunctionEventInvokeConfigsPages mocks base method
+func (_m *MockLambdaAPI) ListFunctionEventInvokeConfigsPages(_param0 *lambda.ListFunctionEventInvokeConfigsInput, _param1 func(*lambda.ListFunctionEventInvokeConfigsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListFunctionEventInvokeConfigsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListFunctionEventInvokeConfigsPages indicates an expected call of ListFunctionEventInvokeConfigsPages
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionEventInvokeConfigsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionEventInvokeConfigsPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionEventInvokeConfigsPages), arg0, arg1)
+}
+
+// ListFunctionEventInvokeConfigsPagesWithContext mocks base method
+func (_m *MockLambdaAPI) ListFunctionEventInvokeConfigsPagesWithContext(_param0 context.Context, _param1 *lambda.ListFunctionEventInvokeConfigsInput, _param2 func(*lambda.ListFunctionEventInvokeConfigsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListFunctionEventInvokeConfigsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListFunctionEventInvokeConfigsPagesWithContext indicates an expected call of ListFunctionEventInvokeConfigsPagesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionEventInvokeConfigsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionEventInvokeConfigsPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionEventInvokeConfigsPagesWithContext), _s...)
+}
+
+// ListFunctionEventInvokeConfigsRequest mocks base method
+func (_m *MockLambdaAPI) ListFunctionEventInvokeConfigsRequest(_param0 *lambda.ListFunctionEventInvokeConfigsInput) (*request.Request, *lambda.ListFunctionEventInvokeConfigsOutput) {
+	ret := _m.ctrl.Call(_m, "ListFunctionEventInvokeConfigsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.ListFunctionEventInvokeConfigsOutput)
+	return ret0, ret1
+}
+
+// ListFunctionEventInvokeConfigsRequest indicates an expected call of ListFunctionEventInvokeConfigsRequest
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionEventInvokeConfigsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionEventInvokeConfigsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionEventInvokeConfigsRequest), arg0)
+}
+
+// ListFunctionEventInvokeConfigsWithContext mocks base method
+func (_m *MockLambdaAPI) ListFunctionEventInvokeConfigsWithContext(_param0 context.Context, _param1 *lambda.ListFunctionEventInvokeConfigsInput, _param2 ...request.Option) (*lambda.ListFunctionEventInvokeConfigsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListFunctionEventInvokeConfigsWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.ListFunctionEventInvokeConfigsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctionEventInvokeConfigsWithContext indicates an expected call of ListFunctionEventInvokeConfigsWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionEventInvokeConfigsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionEventInvokeConfigsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionEventInvokeConfigsWithContext), _s...)
+}
+
+// ListFunctionUrlConfigs mocks base method
+func (_m *MockLambdaAPI) ListFunctionUrlConfigs(_param0 *lambda.ListFunctionUrlConfigsInput) (*lambda.ListFunctionUrlConfigsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListFunctionUrlConfigs", _param0)
+	ret0, _ := ret[0].(*lambda.ListFunctionUrlConfigsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctionUrlConfigs indicates an expected call of ListFunctionUrlConfigs
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionUrlConfigs(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionUrlConfigs", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionUrlConfigs), arg0)
+}
+
+// ListFunctionUrlConfigsPages mocks base method
+func (_m *MockLambdaAPI) ListFunctionUrlConfigsPages(_param0 *lambda.ListFunctionUrlConfigsInput, _param1 func(*lambda.ListFunctionUrlConfigsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListFunctionUrlConfigsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListFunctionUrlConfigsPages indicates an expected call of ListFunctionUrlConfigsPages
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionUrlConfigsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionUrlConfigsPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionUrlConfigsPages), arg0, arg1)
+}
+
+// ListFunctionUrlConfigsPagesWithContext mocks base method
+func (_m *MockLambdaAPI) ListFunctionUrlConfigsPagesWithContext(_param0 context.Context, _param1 *lambda.ListFunctionUrlConfigsInput, _param2 func(*lambda.ListFunctionUrlConfigsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListFunctionUrlConfigsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListFunctionUrlConfigsPagesWithContext indicates an expected call of ListFunctionUrlConfigsPagesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionUrlConfigsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionUrlConfigsPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionUrlConfigsPagesWithContext), _s...)
+}
+
+// ListFunctionUrlConfigsRequest mocks base method
+func (_m *MockLambdaAPI) ListFunctionUrlConfigsRequest(_param0 *lambda.ListFunctionUrlConfigsInput) (*request.Request, *lambda.ListFunctionUrlConfigsOutput) {
+	ret := _m.ctrl.Call(_m, "ListFunctionUrlConfigsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.ListFunctionUrlConfigsOutput)
+	return ret0, ret1
+}
+
+// ListFunctionUrlConfigsRequest indicates an expected call of ListFunctionUrlConfigsRequest
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionUrlConfigsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionUrlConfigsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionUrlConfigsRequest), arg0)
+}
+
+// ListFunctionUrlConfigsWithContext mocks base method
+func (_m *MockLambdaAPI) ListFunctionUrlConfigsWithContext(_param0 context.Context, _param1 *lambda.ListFunctionUrlConfigsInput, _param2 ...request.Option) (*lambda.ListFunctionUrlConfigsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListFunctionUrlConfigsWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.ListFunctionUrlConfigsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctionUrlConfigsWithContext indicates an expected call of ListFunctionUrlConfigsWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionUrlConfigsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionUrlConfigsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionUrlConfigsWithContext), _s...)
+}
+
+// ListFunctions mocks base method
+func (_m *MockLambdaAPI) ListFunctions(_param0 *lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListFunctions", _param0)
+	ret0, _ := ret[0].(*lambda.ListFunctionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctions indicates an expected call of ListFunctions
+func (_mr *MockLambdaAPIMockRecorder) ListFunctions(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctions", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctions), arg0)
+}
+
+// ListFunctionsByCodeSigningConfig mocks base method
+func (_m *MockLambdaAPI) ListFunctionsByCodeSigningConfig(_param0 *lambda.ListFunctionsByCodeSigningConfigInput) (*lambda.ListFunctionsByCodeSigningConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListFunctionsByCodeSigningConfig", _param0)
+	ret0, _ := ret[0].(*lambda.ListFunctionsByCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctionsByCodeSigningConfig indicates an expected call of ListFunctionsByCodeSigningConfig
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionsByCodeSigningConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionsByCodeSigningConfig", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsByCodeSigningConfig), arg0)
+}
+
+// ListFunctionsByCodeSigningConfigPages mocks base method
+func (_m *MockLambdaAPI) ListFunctionsByCodeSigningConfigPages(_param0 *lambda.ListFunctionsByCodeSigningConfigInput, _param1 func(*lambda.ListFunctionsByCodeSigningConfigOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListFunctionsByCodeSigningConfigPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListFunctionsByCodeSigningConfigPages indicates an expected call of ListFunctionsByCodeSigningConfigPages
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionsByCodeSigningConfigPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionsByCodeSigningConfigPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsByCodeSigningConfigPages), arg0, arg1)
+}
+
+// ListFunctionsByCodeSigningConfigPagesWithContext mocks base method
+func (_m *MockLambdaAPI) ListFunctionsByCodeSigningConfigPagesWithContext(_param0 context.Context, _param1 *lambda.ListFunctionsByCodeSigningConfigInput, _param2 func(*lambda.ListFunctionsByCodeSigningConfigOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListFunctionsByCodeSigningConfigPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListFunctionsByCodeSigningConfigPagesWithContext indicates an expected call of ListFunctionsByCodeSigningConfigPagesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionsByCodeSigningConfigPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionsByCodeSigningConfigPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsByCodeSigningConfigPagesWithContext), _s...)
+}
+
+// ListFunctionsByCodeSigningConfigRequest mocks base method
+func (_m *MockLambdaAPI) ListFunctionsByCodeSigningConfigRequest(_param0 *lambda.ListFunctionsByCodeSigningConfigInput) (*request.Request, *lambda.ListFunctionsByCodeSigningConfigOutput) {
+	ret := _m.ctrl.Call(_m, "ListFunctionsByCodeSigningConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.ListFunctionsByCodeSigningConfigOutput)
+	return ret0, ret1
+}
+
+// ListFunctionsByCodeSigningConfigRequest indicates an expected call of ListFunctionsByCodeSigningConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionsByCodeSigningConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionsByCodeSigningConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsByCodeSigningConfigRequest), arg0)
+}
+
+// ListFunctionsByCodeSigningConfigWithContext mocks base method
+func (_m *MockLambdaAPI) ListFunctionsByCodeSigningConfigWithContext(_param0 context.Context, _param1 *lambda.ListFunctionsByCodeSigningConfigInput, _param2 ...request.Option) (*lambda.ListFunctionsByCodeSigningConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListFunctionsByCodeSigningConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.ListFunctionsByCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctionsByCodeSigningConfigWithContext indicates an expected call of ListFunctionsByCodeSigningConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionsByCodeSigningConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionsByCodeSigningConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsByCodeSigningConfigWithContext), _s...)
+}
+
+// ListFunctionsPages mocks base method
+func (_m *MockLambdaAPI) ListFunctionsPages(_param0 *lambda.ListFunctionsInput, _param1 func(*lambda.ListFunctionsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListFunctionsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListFunctionsPages indicates an expected call of ListFunctionsPages
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionsPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsPages), arg0, arg1)
+}
+
+// ListFunctionsPagesWithContext mocks base method
+func (_m *MockLambdaAPI) ListFunctionsPagesWithContext(_param0 context.Context, _param1 *lambda.ListFunctionsInput, _param2 func(*lambda.ListFunctionsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListFunctionsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListFunctionsPagesWithContext indicates an expected call of ListFunctionsPagesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionsPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsPagesWithContext), _s...)
+}
+
+// ListFunctionsRequest mocks base method
+func (_m *MockLambdaAPI) ListFunctionsRequest(_param0 *lambda.ListFunctionsInput) (*request.Request, *lambda.ListFunctionsOutput) {
+	ret := _m.ctrl.Call(_m, "ListFunctionsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.ListFunctionsOutput)
+	return ret0, ret1
+}
+
+// ListFunctionsRequest indicates an expected call of ListFunctionsRequest
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsRequest), arg0)
+}
+
+// ListFunctionsWithContext mocks base method
+func (_m *MockLambdaAPI) ListFunctionsWithContext(_param0 context.Context, _param1 *lambda.ListFunctionsInput, _param2 ...request.Option) (*lambda.ListFunctionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListFunctionsWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.ListFunctionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctionsWithContext indicates an expected call of ListFunctionsWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListFunctionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListFunctionsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsWithContext), _s...)
+}
+
+// ListLayerVersions mocks base method
+func (_m *MockLambdaAPI) ListLayerVersions(_param0 *lambda.ListLayerVersionsInput) (*lambda.ListLayerVersionsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListLayerVersions", _param0)
+	ret0, _ := ret[0].(*lambda.ListLayerVersionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLayerVersions indicates an expected call of ListLayerVersions
+func (_mr *MockLambdaAPIMockRecorder) ListLayerVersions(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListLayerVersions", reflect.TypeOf((*MockLambdaAPI)(nil).ListLayerVersions), arg0)
+}
+
+// ListLayerVersionsPages mocks base method
+func (_m *MockLambdaAPI) ListLayerVersionsPages(_param0 *lambda.ListLayerVersionsInput, _param1 func(*lambda.ListLayerVersionsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListLayerVersionsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListLayerVersionsPages indicates an expected call of ListLayerVersionsPages
+func (_mr *MockLambdaAPIMockRecorder) ListLayerVersionsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListLayerVersionsPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListLayerVersionsPages), arg0, arg1)
+}
+
+// ListLayerVersionsPagesWithContext mocks base method
+func (_m *MockLambdaAPI) ListLayerVersionsPagesWithContext(_param0 context.Context, _param1 *lambda.ListLayerVersionsInput, _param2 func(*lambda.ListLayerVersionsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListLayerVersionsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListLayerVersionsPagesWithContext indicates an expected call of ListLayerVersionsPagesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListLayerVersionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListLayerVersionsPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListLayerVersionsPagesWithContext), _s...)
+}
+
+// ListLayerVersionsRequest mocks base method
+func (_m *MockLambdaAPI) ListLayerVersionsRequest(_param0 *lambda.ListLayerVersionsInput) (*request.Request, *lambda.ListLayerVersionsOutput) {
+	ret := _m.ctrl.Call(_m, "ListLayerVersionsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.ListLayerVersionsOutput)
+	return ret0, ret1
+}
+
+// ListLayerVersionsRequest indicates an expected call of ListLayerVersionsRequest
+func (_mr *MockLambdaAPIMockRecorder) ListLayerVersionsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListLayerVersionsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListLayerVersionsRequest), arg0)
+}
+
+// ListLayerVersionsWithContext mocks base method
+func (_m *MockLambdaAPI) ListLayerVersionsWithContext(_param0 context.Context, _param1 *lambda.ListLayerVersionsInput, _param2 ...request.Option) (*lambda.ListLayerVersionsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListLayerVersionsWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.ListLayerVersionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLayerVersionsWithContext indicates an expected call of ListLayerVersionsWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListLayerVersionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListLayerVersionsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListLayerVersionsWithContext), _s...)
+}
+
+// ListLayers mocks base method
+func (_m *MockLambdaAPI) ListLayers(_param0 *lambda.ListLayersInput) (*lambda.ListLayersOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListLayers", _param0)
+	ret0, _ := ret[0].(*lambda.ListLayersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLayers indicates an expected call of ListLayers
+func (_mr *MockLambdaAPIMockRecorder) ListLayers(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListLayers", reflect.TypeOf((*MockLambdaAPI)(nil).ListLayers), arg0)
+}
+
+// ListLayersPages mocks base method
+func (_m *MockLambdaAPI) ListLayersPages(_param0 *lambda.ListLayersInput, _param1 func(*lambda.ListLayersOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListLayersPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListLayersPages indicates an expected call of ListLayersPages
+func (_mr *MockLambdaAPIMockRecorder) ListLayersPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListLayersPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListLayersPages), arg0, arg1)
+}
+
+// ListLayersPagesWithContext mocks base method
+func (_m *MockLambdaAPI) ListLayersPagesWithContext(_param0 context.Context, _param1 *lambda.ListLayersInput, _param2 func(*lambda.ListLayersOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListLayersPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListLayersPagesWithContext indicates an expected call of ListLayersPagesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListLayersPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListLayersPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListLayersPagesWithContext), _s...)
+}
+
+// ListLayersRequest mocks base method
+func (_m *MockLambdaAPI) ListLayersRequest(_param0 *lambda.ListLayersInput) (*request.Request, *lambda.ListLayersOutput) {
+	ret := _m.ctrl.Call(_m, "ListLayersRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.ListLayersOutput)
+	return ret0, ret1
+}
+
+// ListLayersRequest indicates an expected call of ListLayersRequest
+func (_mr *MockLambdaAPIMockRecorder) ListLayersRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListLayersRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListLayersRequest), arg0)
+}
+
+// ListLayersWithContext mocks base method
+func (_m *MockLambdaAPI) ListLayersWithContext(_param0 context.Context, _param1 *lambda.ListLayersInput, _param2 ...request.Option) (*lambda.ListLayersOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListLayersWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.ListLayersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLayersWithContext indicates an expected call of ListLayersWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListLayersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListLayersWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListLayersWithContext), _s...)
+}
+
+// ListProvisionedConcurrencyConfigs mocks base method
+func (_m *MockLambdaAPI) ListProvisionedConcurrencyConfigs(_param0 *lambda.ListProvisionedConcurrencyConfigsInput) (*lambda.ListProvisionedConcurrencyConfigsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListProvisionedConcurrencyConfigs", _param0)
+	ret0, _ := ret[0].(*lambda.ListProvisionedConcurrencyConfigsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProvisionedConcurrencyConfigs indicates an expected call of ListProvisionedConcurrencyConfigs
+func (_mr *MockLambdaAPIMockRecorder) ListProvisionedConcurrencyConfigs(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListProvisionedConcurrencyConfigs", reflect.TypeOf((*MockLambdaAPI)(nil).ListProvisionedConcurrencyConfigs), arg0)
+}
+
+// ListProvisionedConcurrencyConfigsPages mocks base method
+func (_m *MockLambdaAPI) ListProvisionedConcurrencyConfigsPages(_param0 *lambda.ListProvisionedConcurrencyConfigsInput, _param1 func(*lambda.ListProvisionedConcurrencyConfigsOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListProvisionedConcurrencyConfigsPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListProvisionedConcurrencyConfigsPages indicates an expected call of ListProvisionedConcurrencyConfigsPages
+func (_mr *MockLambdaAPIMockRecorder) ListProvisionedConcurrencyConfigsPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListProvisionedConcurrencyConfigsPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListProvisionedConcurrencyConfigsPages), arg0, arg1)
+}
+
+// ListProvisionedConcurrencyConfigsPagesWithContext mocks base method
+func (_m *MockLambdaAPI) ListProvisionedConcurrencyConfigsPagesWithContext(_param0 context.Context, _param1 *lambda.ListProvisionedConcurrencyConfigsInput, _param2 func(*lambda.ListProvisionedConcurrencyConfigsOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListProvisionedConcurrencyConfigsPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListProvisionedConcurrencyConfigsPagesWithContext indicates an expected call of ListProvisionedConcurrencyConfigsPagesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListProvisionedConcurrencyConfigsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListProvisionedConcurrencyConfigsPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListProvisionedConcurrencyConfigsPagesWithContext), _s...)
+}
+
+// ListProvisionedConcurrencyConfigsRequest mocks base method
+func (_m *MockLambdaAPI) ListProvisionedConcurrencyConfigsRequest(_param0 *lambda.ListProvisionedConcurrencyConfigsInput) (*request.Request, *lambda.ListProvisionedConcurrencyConfigsOutput) {
+	ret := _m.ctrl.Call(_m, "ListProvisionedConcurrencyConfigsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.ListProvisionedConcurrencyConfigsOutput)
+	return ret0, ret1
+}
+
+// ListProvisionedConcurrencyConfigsRequest indicates an expected call of ListProvisionedConcurrencyConfigsRequest
+func (_mr *MockLambdaAPIMockRecorder) ListProvisionedConcurrencyConfigsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListProvisionedConcurrencyConfigsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListProvisionedConcurrencyConfigsRequest), arg0)
+}
+
+// ListProvisionedConcurrencyConfigsWithContext mocks base method
+func (_m *MockLambdaAPI) ListProvisionedConcurrencyConfigsWithContext(_param0 context.Context, _param1 *lambda.ListProvisionedConcurrencyConfigsInput, _param2 ...request.Option) (*lambda.ListProvisionedConcurrencyConfigsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListProvisionedConcurrencyConfigsWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.ListProvisionedConcurrencyConfigsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProvisionedConcurrencyConfigsWithContext indicates an expected call of ListProvisionedConcurrencyConfigsWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListProvisionedConcurrencyConfigsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListProvisionedConcurrencyConfigsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListProvisionedConcurrencyConfigsWithContext), _s...)
+}
+
+// ListTags mocks base method
+func (_m *MockLambdaAPI) ListTags(_param0 *lambda.ListTagsInput) (*lambda.ListTagsOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListTags", _param0)
+	ret0, _ := ret[0].(*lambda.ListTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags
+func (_mr *MockLambdaAPIMockRecorder) ListTags(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListTags", reflect.TypeOf((*MockLambdaAPI)(nil).ListTags), arg0)
+}
+
+// ListTagsRequest mocks base method
+func (_m *MockLambdaAPI) ListTagsRequest(_param0 *lambda.ListTagsInput) (*request.Request, *lambda.ListTagsOutput) {
+	ret := _m.ctrl.Call(_m, "ListTagsRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.ListTagsOutput)
+	return ret0, ret1
+}
+
+// ListTagsRequest indicates an expected call of ListTagsRequest
+func (_mr *MockLambdaAPIMockRecorder) ListTagsRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListTagsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListTagsRequest), arg0)
+}
+
+// ListTagsWithContext mocks base method
+func (_m *MockLambdaAPI) ListTagsWithContext(_param0 context.Context, _param1 *lambda.ListTagsInput, _param2 ...request.Option) (*lambda.ListTagsOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListTagsWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.ListTagsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsWithContext indicates an expected call of ListTagsWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListTagsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListTagsWithContext), _s...)
+}
+
+// ListVersionsByFunction mocks base method
+func (_m *MockLambdaAPI) ListVersionsByFunction(_param0 *lambda.ListVersionsByFunctionInput) (*lambda.ListVersionsByFunctionOutput, error) {
+	ret := _m.ctrl.Call(_m, "ListVersionsByFunction", _param0)
+	ret0, _ := ret[0].(*lambda.ListVersionsByFunctionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVersionsByFunction indicates an expected call of ListVersionsByFunction
+func (_mr *MockLambdaAPIMockRecorder) ListVersionsByFunction(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListVersionsByFunction", reflect.TypeOf((*MockLambdaAPI)(nil).ListVersionsByFunction), arg0)
+}
+
+// ListVersionsByFunctionPages mocks base method
+func (_m *MockLambdaAPI) ListVersionsByFunctionPages(_param0 *lambda.ListVersionsByFunctionInput, _param1 func(*lambda.ListVersionsByFunctionOutput, bool) bool) error {
+	ret := _m.ctrl.Call(_m, "ListVersionsByFunctionPages", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListVersionsByFunctionPages indicates an expected call of ListVersionsByFunctionPages
+func (_mr *MockLambdaAPIMockRecorder) ListVersionsByFunctionPages(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListVersionsByFunctionPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListVersionsByFunctionPages), arg0, arg1)
+}
+
+// ListVersionsByFunctionPagesWithContext mocks base method
+func (_m *MockLambdaAPI) ListVersionsByFunctionPagesWithContext(_param0 context.Context, _param1 *lambda.ListVersionsByFunctionInput, _param2 func(*lambda.ListVersionsByFunctionOutput, bool) bool, _param3 ...request.Option) error {
+	_s := []interface{}{_param0, _param1, _param2}
+	for _, _x := range _param3 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "ListVersionsByFunctionPagesWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListVersionsByFunctionPagesWithContext indicates an expected call of ListVersionsByFunctionPagesWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListVersionsByFunctionPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListVersionsByFunctionPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListVersionsByFunctionPagesWithContext), _s...)
 }
 
-// ListAliasesRequest mocks base method
-func (m *MockLambdaAPI) ListAliasesRequest(arg0 *lambda.ListAliasesInput) (*request.Request, *lambda.ListAliasesOutput) {
-	ret := m.ctrl.Call(m, "ListAliasesRequest", arg0)
+// ListVersionsByFunctionRequest mocks base method
+func (_m *MockLambdaAPI) ListVersionsByFunctionRequest(_param0 *lambda.ListVersionsByFunctionInput) (*request.Request, *lambda.ListVersionsByFunctionOutput) {
+	ret := _m.ctrl.Call(_m, "ListVersionsByFunctionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.ListAliasesOutput)
+	ret1, _ := ret[1].(*lambda.ListVersionsByFunctionOutput)
 	return ret0, ret1
 }
 
-// ListAliasesRequest indicates an expected call of ListAliasesRequest
-func (mr *MockLambdaAPIMockRecorder) ListAliasesRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAliasesRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListAliasesRequest), arg0)
+// ListVersionsByFunctionRequest indicates an expected call of ListVersionsByFunctionRequest
+func (_mr *MockLambdaAPIMockRecorder) ListVersionsByFunctionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListVersionsByFunctionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListVersionsByFunctionRequest), arg0)
 }
 
-// ListAliasesWithContext mocks base method
-func (m *MockLambdaAPI) ListAliasesWithContext(arg0 aws.Context, arg1 *lambda.ListAliasesInput, arg2 ...request.Option) (*lambda.ListAliasesOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+// ListVersionsByFunctionWithContext mocks base method
+func (_m *MockLambdaAPI) ListVersionsByFunctionWithContext(_param0 context.Context, _param1 *lambda.ListVersionsByFunctionInput, _param2 ...request.Option) (*lambda.ListVersionsByFunctionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "ListAliasesWithContext", varargs...)
-	ret0, _ := ret[0].(*lambda.ListAliasesOutput)
+	ret := _m.ctrl.Call(_m, "ListVersionsByFunctionWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.ListVersionsByFunctionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAliasesWithContext indicates an expected call of ListAliasesWithContext
-func (mr *MockLambdaAPIMockRecorder) ListAliasesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAliasesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListAliasesWithContext), varargs...)
+// ListVersionsByFunctionWithContext indicates an expected call of ListVersionsByFunctionWithContext
+func (_mr *MockLambdaAPIMockRecorder) ListVersionsByFunctionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "ListVersionsByFunctionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListVersionsByFunctionWithContext), _s...)
 }
 
-// ListEventSourceMappings mocks base method
-func (m *MockLambdaAPI) ListEventSourceMappings(arg0 *lambda.ListEventSourceMappingsInput) (*lambda.ListEventSourceMappingsOutput, error) {
-	ret := m.ctrl.Call(m, "ListEventSourceMappings", arg0)
-	ret0, _ := ret[0].(*lambda.ListEventSourceMappingsOutput)
+// PublishLayerVersion mocks base method
+func (_m *MockLambdaAPI) PublishLayerVersion(_param0 *lambda.PublishLayerVersionInput) (*lambda.PublishLayerVersionOutput, error) {
+	ret := _m.ctrl.Call(_m, "PublishLayerVersion", _param0)
+	ret0, _ := ret[0].(*lambda.PublishLayerVersionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEventSourceMappings indicates an expected call of ListEventSourceMappings
-func (mr *MockLambdaAPIMockRecorder) ListEventSourceMappings(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSourceMappings", reflect.TypeOf((*MockLambdaAPI)(nil).ListEventSourceMappings), arg0)
+// PublishLayerVersion indicates an expected call of PublishLayerVersion
+func (_mr *MockLambdaAPIMockRecorder) PublishLayerVersion(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PublishLayerVersion", reflect.TypeOf((*MockLambdaAPI)(nil).PublishLayerVersion), arg0)
 }
 
-// ListEventSourceMappingsPages mocks base method
-func (m *MockLambdaAPI) ListEventSourceMappingsPages(arg0 *lambda.ListEventSourceMappingsInput, arg1 func(*lambda.ListEventSourceMappingsOutput, bool) bool) error {
-	ret := m.ctrl.Call(m, "ListEventSourceMappingsPages", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+// PublishLayerVersionRequest mocks base method
+func (_m *MockLambdaAPI) PublishLayerVersionRequest(_param0 *lambda.PublishLayerVersionInput) (*request.Request, *lambda.PublishLayerVersionOutput) {
+	ret := _m.ctrl.Call(_m, "PublishLayerVersionRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.PublishLayerVersionOutput)
+	return ret0, ret1
 }
 
-// ListEventSourceMappingsPages indicates an expected call of ListEventSourceMappingsPages
-func (mr *MockLambdaAPIMockRecorder) ListEventSourceMappingsPages(arg0, arg1 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSourceMappingsPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListEventSourceMappingsPages), arg0, arg1)
+// PublishLayerVersionRequest indicates an expected call of PublishLayerVersionRequest
+func (_mr *MockLambdaAPIMockRecorder) PublishLayerVersionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PublishLayerVersionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).PublishLayerVersionRequest), arg0)
 }
 
-// ListEventSourceMappingsPagesWithContext mocks base method
-func (m *MockLambdaAPI) ListEventSourceMappingsPagesWithContext(arg0 aws.Context, arg1 *lambda.ListEventSourceMappingsInput, arg2 func(*lambda.ListEventSourceMappingsOutput, bool) bool, arg3 ...request.Option) error {
-	varargs := []interface{}{arg0, arg1, arg2}
-	for _, a := range arg3 {
-		varargs = append(varargs, a)
+// PublishLayerVersionWithContext mocks base method
+func (_m *MockLambdaAPI) PublishLayerVersionWithContext(_param0 context.Context, _param1 *lambda.PublishLayerVersionInput, _param2 ...request.Option) (*lambda.PublishLayerVersionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "ListEventSourceMappingsPagesWithContext", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := _m.ctrl.Call(_m, "PublishLayerVersionWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.PublishLayerVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ListEventSourceMappingsPagesWithContext indicates an expected call of ListEventSourceMappingsPagesWithContext
-func (mr *MockLambdaAPIMockRecorder) ListEventSourceMappingsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSourceMappingsPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListEventSourceMappingsPagesWithContext), varargs...)
+// PublishLayerVersionWithContext indicates an expected call of PublishLayerVersionWithContext
+func (_mr *MockLambdaAPIMockRecorder) PublishLayerVersionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PublishLayerVersionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).PublishLayerVersionWithContext), _s...)
 }
 
-// ListEventSourceMappingsRequest mocks base method
-func (m *MockLambdaAPI) ListEventSourceMappingsRequest(arg0 *lambda.ListEventSourceMappingsInput) (*request.Request, *lambda.ListEventSourceMappingsOutput) {
-	ret := m.ctrl.Call(m, "ListEventSourceMappingsRequest", arg0)
+// PublishVersion mocks base method
+func (_m *MockLambdaAPI) PublishVersion(_param0 *lambda.PublishVersionInput) (*lambda.FunctionConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "PublishVersion", _param0)
+	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishVersion indicates an expected call of PublishVersion
+func (_mr *MockLambdaAPIMockRecorder) PublishVersion(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PublishVersion", reflect.TypeOf((*MockLambdaAPI)(nil).PublishVersion), arg0)
+}
+
+// PublishVersionRequest mocks base method
+func (_m *MockLambdaAPI) PublishVersionRequest(_param0 *lambda.PublishVersionInput) (*request.Request, *lambda.FunctionConfiguration) {
+	ret := _m.ctrl.Call(_m, "PublishVersionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.ListEventSourceMappingsOutput)
+	ret1, _ := ret[1].(*lambda.FunctionConfiguration)
 	return ret0, ret1
 }
 
-// ListEventSourceMappingsRequest indicates an expected call of ListEventSourceMappingsRequest
-func (mr *MockLambdaAPIMockRecorder) ListEventSourceMappingsRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSourceMappingsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListEventSourceMappingsRequest), arg0)
+// PublishVersionRequest indicates an expected call of PublishVersionRequest
+func (_mr *MockLambdaAPIMockRecorder) PublishVersionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PublishVersionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).PublishVersionRequest), arg0)
 }
 
-// ListEventSourceMappingsWithContext mocks base method
-func (m *MockLambdaAPI) ListEventSourceMappingsWithContext(arg0 aws.Context, arg1 *lambda.ListEventSourceMappingsInput, arg2 ...request.Option) (*lambda.ListEventSourceMappingsOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+// PublishVersionWithContext mocks base method
+func (_m *MockLambdaAPI) PublishVersionWithContext(_param0 context.Context, _param1 *lambda.PublishVersionInput, _param2 ...request.Option) (*lambda.FunctionConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "ListEventSourceMappingsWithContext", varargs...)
-	ret0, _ := ret[0].(*lambda.ListEventSourceMappingsOutput)
+	ret := _m.ctrl.Call(_m, "PublishVersionWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEventSourceMappingsWithContext indicates an expected call of ListEventSourceMappingsWithContext
-func (mr *MockLambdaAPIMockRecorder) ListEventSourceMappingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSourceMappingsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListEventSourceMappingsWithContext), varargs...)
+// PublishVersionWithContext indicates an expected call of PublishVersionWithContext
+func (_mr *MockLambdaAPIMockRecorder) PublishVersionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PublishVersionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).PublishVersionWithContext), _s...)
 }
 
-// ListFunctions mocks base method
-func (m *MockLambdaAPI) ListFunctions(arg0 *lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error) {
-	ret := m.ctrl.Call(m, "ListFunctions", arg0)
-	ret0, _ := ret[0].(*lambda.ListFunctionsOutput)
+// PutFunctionCodeSigningConfig mocks base method
+func (_m *MockLambdaAPI) PutFunctionCodeSigningConfig(_param0 *lambda.PutFunctionCodeSigningConfigInput) (*lambda.PutFunctionCodeSigningConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "PutFunctionCodeSigningConfig", _param0)
+	ret0, _ := ret[0].(*lambda.PutFunctionCodeSigningConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListFunctions indicates an expected call of ListFunctions
-func (mr *MockLambdaAPIMockRecorder) ListFunctions(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFunctions", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctions), arg0)
+// PutFunctionCodeSigningConfig indicates an expected call of PutFunctionCodeSigningConfig
+func (_mr *MockLambdaAPIMockRecorder) PutFunctionCodeSigningConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutFunctionCodeSigningConfig", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionCodeSigningConfig), arg0)
 }
 
-// ListFunctionsPages mocks base method
-func (m *MockLambdaAPI) ListFunctionsPages(arg0 *lambda.ListFunctionsInput, arg1 func(*lambda.ListFunctionsOutput, bool) bool) error {
-	ret := m.ctrl.Call(m, "ListFunctionsPages", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+// PutFunctionCodeSigningConfigRequest mocks base method
+func (_m *MockLambdaAPI) PutFunctionCodeSigningConfigRequest(_param0 *lambda.PutFunctionCodeSigningConfigInput) (*request.Request, *lambda.PutFunctionCodeSigningConfigOutput) {
+	ret := _m.ctrl.Call(_m, "PutFunctionCodeSigningConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.PutFunctionCodeSigningConfigOutput)
+	return ret0, ret1
 }
 
-// ListFunctionsPages indicates an expected call of ListFunctionsPages
-func (mr *MockLambdaAPIMockRecorder) ListFunctionsPages(arg0, arg1 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFunctionsPages", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsPages), arg0, arg1)
+// PutFunctionCodeSigningConfigRequest indicates an expected call of PutFunctionCodeSigningConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) PutFunctionCodeSigningConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutFunctionCodeSigningConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionCodeSigningConfigRequest), arg0)
 }
 
-// ListFunctionsPagesWithContext mocks base method
-func (m *MockLambdaAPI) ListFunctionsPagesWithContext(arg0 aws.Context, arg1 *lambda.ListFunctionsInput, arg2 func(*lambda.ListFunctionsOutput, bool) bool, arg3 ...request.Option) error {
-	varargs := []interface{}{arg0, arg1, arg2}
-	for _, a := range arg3 {
-		varargs = append(varargs, a)
+// PutFunctionCodeSigningConfigWithContext mocks base method
+func (_m *MockLambdaAPI) PutFunctionCodeSigningConfigWithContext(_param0 context.Context, _param1 *lambda.PutFunctionCodeSigningConfigInput, _param2 ...request.Option) (*lambda.PutFunctionCodeSigningConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "ListFunctionsPagesWithContext", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := _m.ctrl.Call(_m, "PutFunctionCodeSigningConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.PutFunctionCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ListFunctionsPagesWithContext indicates an expected call of ListFunctionsPagesWithContext
-func (mr *MockLambdaAPIMockRecorder) ListFunctionsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFunctionsPagesWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsPagesWithContext), varargs...)
+// PutFunctionCodeSigningConfigWithContext indicates an expected call of PutFunctionCodeSigningConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) PutFunctionCodeSigningConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutFunctionCodeSigningConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionCodeSigningConfigWithContext), _s...)
 }
 
-// ListFunctionsRequest mocks base method
-func (m *MockLambdaAPI) ListFunctionsRequest(arg0 *lambda.ListFunctionsInput) (*request.Request, *lambda.ListFunctionsOutput) {
-	ret := m.ctrl.Call(m, "ListFunctionsRequest", arg0)
+// PutFunctionConcurrency mocks base method
+func (_m *MockLambdaAPI) PutFunctionConcurrency(_param0 *lambda.PutFunctionConcurrencyInput) (*lambda.PutFunctionConcurrencyOutput, error) {
+	ret := _m.ctrl.Call(_m, "PutFunctionConcurrency", _param0)
+	ret0, _ := ret[0].(*lambda.PutFunctionConcurrencyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutFunctionConcurrency indicates an expected call of PutFunctionConcurrency
+func (_mr *MockLambdaAPIMockRecorder) PutFunctionConcurrency(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutFunctionConcurrency", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionConcurrency), arg0)
+}
+
+// PutFunctionConcurrencyRequest mocks base method
+func (_m *MockLambdaAPI) PutFunctionConcurrencyRequest(_param0 *lambda.PutFunctionConcurrencyInput) (*request.Request, *lambda.PutFunctionConcurrencyOutput) {
+	ret := _m.ctrl.Call(_m, "PutFunctionConcurrencyRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.ListFunctionsOutput)
+	ret1, _ := ret[1].(*lambda.PutFunctionConcurrencyOutput)
 	return ret0, ret1
 }
 
-// ListFunctionsRequest indicates an expected call of ListFunctionsRequest
-func (mr *MockLambdaAPIMockRecorder) ListFunctionsRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFunctionsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsRequest), arg0)
+// PutFunctionConcurrencyRequest indicates an expected call of PutFunctionConcurrencyRequest
+func (_mr *MockLambdaAPIMockRecorder) PutFunctionConcurrencyRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutFunctionConcurrencyRequest", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionConcurrencyRequest), arg0)
 }
 
-// ListFunctionsWithContext mocks base method
-func (m *MockLambdaAPI) ListFunctionsWithContext(arg0 aws.Context, arg1 *lambda.ListFunctionsInput, arg2 ...request.Option) (*lambda.ListFunctionsOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+// PutFunctionConcurrencyWithContext mocks base method
+func (_m *MockLambdaAPI) PutFunctionConcurrencyWithContext(_param0 context.Context, _param1 *lambda.PutFunctionConcurrencyInput, _param2 ...request.Option) (*lambda.PutFunctionConcurrencyOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "ListFunctionsWithContext", varargs...)
-	ret0, _ := ret[0].(*lambda.ListFunctionsOutput)
+	ret := _m.ctrl.Call(_m, "PutFunctionConcurrencyWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.PutFunctionConcurrencyOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListFunctionsWithContext indicates an expected call of ListFunctionsWithContext
-func (mr *MockLambdaAPIMockRecorder) ListFunctionsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFunctionsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListFunctionsWithContext), varargs...)
+// PutFunctionConcurrencyWithContext indicates an expected call of PutFunctionConcurrencyWithContext
+func (_mr *MockLambdaAPIMockRecorder) PutFunctionConcurrencyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutFunctionConcurrencyWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionConcurrencyWithContext), _s...)
 }
 
-// ListTags mocks base method
-func (m *MockLambdaAPI) ListTags(arg0 *lambda.ListTagsInput) (*lambda.ListTagsOutput, error) {
-	ret := m.ctrl.Call(m, "ListTags", arg0)
-	ret0, _ := ret[0].(*lambda.ListTagsOutput)
+// PutFunctionEventInvokeConfig mocks base method
+func (_m *MockLambdaAPI) PutFunctionEventInvokeConfig(_param0 *lambda.PutFunctionEventInvokeConfigInput) (*lambda.PutFunctionEventInvokeConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "PutFunctionEventInvokeConfig", _param0)
+	ret0, _ := ret[0].(*lambda.PutFunctionEventInvokeConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTags indicates an expected call of ListTags
-func (mr *MockLambdaAPIMockRecorder) ListTags(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockLambdaAPI)(nil).ListTags), arg0)
+// PutFunctionEventInvokeConfig indicates an expected call of PutFunctionEventInvokeConfig
+func (_mr *MockLambdaAPIMockRecorder) PutFunctionEventInvokeConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutFunctionEventInvokeConfig", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionEventInvokeConfig), arg0)
 }
 
-// ListTagsRequest mocks base method
-func (m *MockLambdaAPI) ListTagsRequest(arg0 *lambda.ListTagsInput) (*request.Request, *lambda.ListTagsOutput) {
-	ret := m.ctrl.Call(m, "ListTagsRequest", arg0)
+// PutFunctionEventInvokeConfigRequest mocks base method
+func (_m *MockLambdaAPI) PutFunctionEventInvokeConfigRequest(_param0 *lambda.PutFunctionEventInvokeConfigInput) (*request.Request, *lambda.PutFunctionEventInvokeConfigOutput) {
+	ret := _m.ctrl.Call(_m, "PutFunctionEventInvokeConfigRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.ListTagsOutput)
+	ret1, _ := ret[1].(*lambda.PutFunctionEventInvokeConfigOutput)
 	return ret0, ret1
 }
 
-// ListTagsRequest indicates an expected call of ListTagsRequest
-func (mr *MockLambdaAPIMockRecorder) ListTagsRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListTagsRequest), arg0)
+// PutFunctionEventInvokeConfigRequest indicates an expected call of PutFunctionEventInvokeConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) PutFunctionEventInvokeConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutFunctionEventInvokeConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionEventInvokeConfigRequest), arg0)
 }
 
-// ListTagsWithContext mocks base method
-func (m *MockLambdaAPI) ListTagsWithContext(arg0 aws.Context, arg1 *lambda.ListTagsInput, arg2 ...request.Option) (*lambda.ListTagsOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+// PutFunctionEventInvokeConfigWithContext mocks base method
+func (_m *MockLambdaAPI) PutFunctionEventInvokeConfigWithContext(_param0 context.Context, _param1 *lambda.PutFunctionEventInvokeConfigInput, _param2 ...request.Option) (*lambda.PutFunctionEventInvokeConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "ListTagsWithContext", varargs...)
-	ret0, _ := ret[0].(*lambda.ListTagsOutput)
+	ret := _m.ctrl.Call(_m, "PutFunctionEventInvokeConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.PutFunctionEventInvokeConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTagsWithContext indicates an expected call of ListTagsWithContext
-func (mr *MockLambdaAPIMockRecorder) ListTagsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListTagsWithContext), varargs...)
+// PutFunctionEventInvokeConfigWithContext indicates an expected call of PutFunctionEventInvokeConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) PutFunctionEventInvokeConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutFunctionEventInvokeConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionEventInvokeConfigWithContext), _s...)
 }
 
-// ListVersionsByFunction mocks base method
-func (m *MockLambdaAPI) ListVersionsByFunction(arg0 *lambda.ListVersionsByFunctionInput) (*lambda.ListVersionsByFunctionOutput, error) {
-	ret := m.ctrl.Call(m, "ListVersionsByFunction", arg0)
-	ret0, _ := ret[0].(*lambda.ListVersionsByFunctionOutput)
+// PutProvisionedConcurrencyConfig mocks base method
+func (_m *MockLambdaAPI) PutProvisionedConcurrencyConfig(_param0 *lambda.PutProvisionedConcurrencyConfigInput) (*lambda.PutProvisionedConcurrencyConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "PutProvisionedConcurrencyConfig", _param0)
+	ret0, _ := ret[0].(*lambda.PutProvisionedConcurrencyConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVersionsByFunction indicates an expected call of ListVersionsByFunction
-func (mr *MockLambdaAPIMockRecorder) ListVersionsByFunction(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersionsByFunction", reflect.TypeOf((*MockLambdaAPI)(nil).ListVersionsByFunction), arg0)
+// PutProvisionedConcurrencyConfig indicates an expected call of PutProvisionedConcurrencyConfig
+func (_mr *MockLambdaAPIMockRecorder) PutProvisionedConcurrencyConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutProvisionedConcurrencyConfig", reflect.TypeOf((*MockLambdaAPI)(nil).PutProvisionedConcurrencyConfig), arg0)
 }
 
-// ListVersionsByFunctionRequest mocks base method
-func (m *MockLambdaAPI) ListVersionsByFunctionRequest(arg0 *lambda.ListVersionsByFunctionInput) (*request.Request, *lambda.ListVersionsByFunctionOutput) {
-	ret := m.ctrl.Call(m, "ListVersionsByFunctionRequest", arg0)
+// PutProvisionedConcurrencyConfigRequest mocks base method
+func (_m *MockLambdaAPI) PutProvisionedConcurrencyConfigRequest(_param0 *lambda.PutProvisionedConcurrencyConfigInput) (*request.Request, *lambda.PutProvisionedConcurrencyConfigOutput) {
+	ret := _m.ctrl.Call(_m, "PutProvisionedConcurrencyConfigRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.ListVersionsByFunctionOutput)
+	ret1, _ := ret[1].(*lambda.PutProvisionedConcurrencyConfigOutput)
 	return ret0, ret1
 }
 
-// ListVersionsByFunctionRequest indicates an expected call of ListVersionsByFunctionRequest
-func (mr *MockLambdaAPIMockRecorder) ListVersionsByFunctionRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersionsByFunctionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).ListVersionsByFunctionRequest), arg0)
+// PutProvisionedConcurrencyConfigRequest indicates an expected call of PutProvisionedConcurrencyConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) PutProvisionedConcurrencyConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutProvisionedConcurrencyConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).PutProvisionedConcurrencyConfigRequest), arg0)
 }
 
-// ListVersionsByFunctionWithContext mocks base method
-func (m *MockLambdaAPI) ListVersionsByFunctionWithContext(arg0 aws.Context, arg1 *lambda.ListVersionsByFunctionInput, arg2 ...request.Option) (*lambda.ListVersionsByFunctionOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+// PutProvisionedConcurrencyConfigWithContext mocks base method
+func (_m *MockLambdaAPI) PutProvisionedConcurrencyConfigWithContext(_param0 context.Context, _param1 *lambda.PutProvisionedConcurrencyConfigInput, _param2 ...request.Option) (*lambda.PutProvisionedConcurrencyConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "ListVersionsByFunctionWithContext", varargs...)
-	ret0, _ := ret[0].(*lambda.ListVersionsByFunctionOutput)
+	ret := _m.ctrl.Call(_m, "PutProvisionedConcurrencyConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.PutProvisionedConcurrencyConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVersionsByFunctionWithContext indicates an expected call of ListVersionsByFunctionWithContext
-func (mr *MockLambdaAPIMockRecorder) ListVersionsByFunctionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersionsByFunctionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).ListVersionsByFunctionWithContext), varargs...)
+// PutProvisionedConcurrencyConfigWithContext indicates an expected call of PutProvisionedConcurrencyConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) PutProvisionedConcurrencyConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutProvisionedConcurrencyConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).PutProvisionedConcurrencyConfigWithContext), _s...)
 }
 
-// PublishVersion mocks base method
-func (m *MockLambdaAPI) PublishVersion(arg0 *lambda.PublishVersionInput) (*lambda.FunctionConfiguration, error) {
-	ret := m.ctrl.Call(m, "PublishVersion", arg0)
-	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
+// PutRuntimeManagementConfig mocks base method
+func (_m *MockLambdaAPI) PutRuntimeManagementConfig(_param0 *lambda.PutRuntimeManagementConfigInput) (*lambda.PutRuntimeManagementConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "PutRuntimeManagementConfig", _param0)
+	ret0, _ := ret[0].(*lambda.PutRuntimeManagementConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PublishVersion indicates an expected call of PublishVersion
-func (mr *MockLambdaAPIMockRecorder) PublishVersion(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishVersion", reflect.TypeOf((*MockLambdaAPI)(nil).PublishVersion), arg0)
+// PutRuntimeManagementConfig indicates an expected call of PutRuntimeManagementConfig
+func (_mr *MockLambdaAPIMockRecorder) PutRuntimeManagementConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutRuntimeManagementConfig", reflect.TypeOf((*MockLambdaAPI)(nil).PutRuntimeManagementConfig), arg0)
 }
 
-// PublishVersionRequest mocks base method
-func (m *MockLambdaAPI) PublishVersionRequest(arg0 *lambda.PublishVersionInput) (*request.Request, *lambda.FunctionConfiguration) {
-	ret := m.ctrl.Call(m, "PublishVersionRequest", arg0)
+// PutRuntimeManagementConfigRequest mocks base method
+func (_m *MockLambdaAPI) PutRuntimeManagementConfigRequest(_param0 *lambda.PutRuntimeManagementConfigInput) (*request.Request, *lambda.PutRuntimeManagementConfigOutput) {
+	ret := _m.ctrl.Call(_m, "PutRuntimeManagementConfigRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.FunctionConfiguration)
+	ret1, _ := ret[1].(*lambda.PutRuntimeManagementConfigOutput)
 	return ret0, ret1
 }
 
-// PublishVersionRequest indicates an expected call of PublishVersionRequest
-func (mr *MockLambdaAPIMockRecorder) PublishVersionRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishVersionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).PublishVersionRequest), arg0)
+// PutRuntimeManagementConfigRequest indicates an expected call of PutRuntimeManagementConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) PutRuntimeManagementConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutRuntimeManagementConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).PutRuntimeManagementConfigRequest), arg0)
 }
 
-// PublishVersionWithContext mocks base method
-func (m *MockLambdaAPI) PublishVersionWithContext(arg0 aws.Context, arg1 *lambda.PublishVersionInput, arg2 ...request.Option) (*lambda.FunctionConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+// PutRuntimeManagementConfigWithContext mocks base method
+func (_m *MockLambdaAPI) PutRuntimeManagementConfigWithContext(_param0 context.Context, _param1 *lambda.PutRuntimeManagementConfigInput, _param2 ...request.Option) (*lambda.PutRuntimeManagementConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "PublishVersionWithContext", varargs...)
-	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
+	ret := _m.ctrl.Call(_m, "PutRuntimeManagementConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.PutRuntimeManagementConfigOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PublishVersionWithContext indicates an expected call of PublishVersionWithContext
-func (mr *MockLambdaAPIMockRecorder) PublishVersionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishVersionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).PublishVersionWithContext), varargs...)
+// PutRuntimeManagementConfigWithContext indicates an expected call of PutRuntimeManagementConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) PutRuntimeManagementConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "PutRuntimeManagementConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).PutRuntimeManagementConfigWithContext), _s...)
 }
 
-// PutFunctionConcurrency mocks base method
-func (m *MockLambdaAPI) PutFunctionConcurrency(arg0 *lambda.PutFunctionConcurrencyInput) (*lambda.PutFunctionConcurrencyOutput, error) {
-	ret := m.ctrl.Call(m, "PutFunctionConcurrency", arg0)
-	ret0, _ := ret[0].(*lambda.PutFunctionConcurrencyOutput)
+// RemoveLayerVersionPermission mocks base method
+func (_m *MockLambdaAPI) RemoveLayerVersionPermission(_param0 *lambda.RemoveLayerVersionPermissionInput) (*lambda.RemoveLayerVersionPermissionOutput, error) {
+	ret := _m.ctrl.Call(_m, "RemoveLayerVersionPermission", _param0)
+	ret0, _ := ret[0].(*lambda.RemoveLayerVersionPermissionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PutFunctionConcurrency indicates an expected call of PutFunctionConcurrency
-func (mr *MockLambdaAPIMockRecorder) PutFunctionConcurrency(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutFunctionConcurrency", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionConcurrency), arg0)
+// RemoveLayerVersionPermission indicates an expected call of RemoveLayerVersionPermission
+func (_mr *MockLambdaAPIMockRecorder) RemoveLayerVersionPermission(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "RemoveLayerVersionPermission", reflect.TypeOf((*MockLambdaAPI)(nil).RemoveLayerVersionPermission), arg0)
 }
 
-// PutFunctionConcurrencyRequest mocks base method
-func (m *MockLambdaAPI) PutFunctionConcurrencyRequest(arg0 *lambda.PutFunctionConcurrencyInput) (*request.Request, *lambda.PutFunctionConcurrencyOutput) {
-	ret := m.ctrl.Call(m, "PutFunctionConcurrencyRequest", arg0)
+// RemoveLayerVersionPermissionRequest mocks base method
+func (_m *MockLambdaAPI) RemoveLayerVersionPermissionRequest(_param0 *lambda.RemoveLayerVersionPermissionInput) (*request.Request, *lambda.RemoveLayerVersionPermissionOutput) {
+	ret := _m.ctrl.Call(_m, "RemoveLayerVersionPermissionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
-	ret1, _ := ret[1].(*lambda.PutFunctionConcurrencyOutput)
+	ret1, _ := ret[1].(*lambda.RemoveLayerVersionPermissionOutput)
 	return ret0, ret1
 }
 
-// PutFunctionConcurrencyRequest indicates an expected call of PutFunctionConcurrencyRequest
-func (mr *MockLambdaAPIMockRecorder) PutFunctionConcurrencyRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutFunctionConcurrencyRequest", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionConcurrencyRequest), arg0)
+// RemoveLayerVersionPermissionRequest indicates an expected call of RemoveLayerVersionPermissionRequest
+func (_mr *MockLambdaAPIMockRecorder) RemoveLayerVersionPermissionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "RemoveLayerVersionPermissionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).RemoveLayerVersionPermissionRequest), arg0)
 }
 
-// PutFunctionConcurrencyWithContext mocks base method
-func (m *MockLambdaAPI) PutFunctionConcurrencyWithContext(arg0 aws.Context, arg1 *lambda.PutFunctionConcurrencyInput, arg2 ...request.Option) (*lambda.PutFunctionConcurrencyOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+// RemoveLayerVersionPermissionWithContext mocks base method
+func (_m *MockLambdaAPI) RemoveLayerVersionPermissionWithContext(_param0 context.Context, _param1 *lambda.RemoveLayerVersionPermissionInput, _param2 ...request.Option) (*lambda.RemoveLayerVersionPermissionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "PutFunctionConcurrencyWithContext", varargs...)
-	ret0, _ := ret[0].(*lambda.PutFunctionConcurrencyOutput)
+	ret := _m.ctrl.Call(_m, "RemoveLayerVersionPermissionWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.RemoveLayerVersionPermissionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PutFunctionConcurrencyWithContext indicates an expected call of PutFunctionConcurrencyWithContext
-func (mr *MockLambdaAPIMockRecorder) PutFunctionConcurrencyWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutFunctionConcurrencyWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).PutFunctionConcurrencyWithContext), varargs...)
+// RemoveLayerVersionPermissionWithContext indicates an expected call of RemoveLayerVersionPermissionWithContext
+func (_mr *MockLambdaAPIMockRecorder) RemoveLayerVersionPermissionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "RemoveLayerVersionPermissionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).RemoveLayerVersionPermissionWithContext), _s...)
 }
 
 // RemovePermission mocks base method
-func (m *MockLambdaAPI) RemovePermission(arg0 *lambda.RemovePermissionInput) (*lambda.RemovePermissionOutput, error) {
-	ret := m.ctrl.Call(m, "RemovePermission", arg0)
+func (_m *MockLambdaAPI) RemovePermission(_param0 *lambda.RemovePermissionInput) (*lambda.RemovePermissionOutput, error) {
+	ret := _m.ctrl.Call(_m, "RemovePermission", _param0)
 	ret0, _ := ret[0].(*lambda.RemovePermissionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemovePermission indicates an expected call of RemovePermission
-func (mr *MockLambdaAPIMockRecorder) RemovePermission(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePermission", reflect.TypeOf((*MockLambdaAPI)(nil).RemovePermission), arg0)
+func (_mr *MockLambdaAPIMockRecorder) RemovePermission(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "RemovePermission", reflect.TypeOf((*MockLambdaAPI)(nil).RemovePermission), arg0)
 }
 
 // RemovePermissionRequest mocks base method
-func (m *MockLambdaAPI) RemovePermissionRequest(arg0 *lambda.RemovePermissionInput) (*request.Request, *lambda.RemovePermissionOutput) {
-	ret := m.ctrl.Call(m, "RemovePermissionRequest", arg0)
+func (_m *MockLambdaAPI) RemovePermissionRequest(_param0 *lambda.RemovePermissionInput) (*request.Request, *lambda.RemovePermissionOutput) {
+	ret := _m.ctrl.Call(_m, "RemovePermissionRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.RemovePermissionOutput)
 	return ret0, ret1
 }
 
 // RemovePermissionRequest indicates an expected call of RemovePermissionRequest
-func (mr *MockLambdaAPIMockRecorder) RemovePermissionRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePermissionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).RemovePermissionRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) RemovePermissionRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "RemovePermissionRequest", reflect.TypeOf((*MockLambdaAPI)(nil).RemovePermissionRequest), arg0)
 }
 
 // RemovePermissionWithContext mocks base method
-func (m *MockLambdaAPI) RemovePermissionWithContext(arg0 aws.Context, arg1 *lambda.RemovePermissionInput, arg2 ...request.Option) (*lambda.RemovePermissionOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) RemovePermissionWithContext(_param0 context.Context, _param1 *lambda.RemovePermissionInput, _param2 ...request.Option) (*lambda.RemovePermissionOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "RemovePermissionWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "RemovePermissionWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.RemovePermissionOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemovePermissionWithContext indicates an expected call of RemovePermissionWithContext
-func (mr *MockLambdaAPIMockRecorder) RemovePermissionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePermissionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).RemovePermissionWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) RemovePermissionWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "RemovePermissionWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).RemovePermissionWithContext), _s...)
 }
 
 // TagResource mocks base method
-func (m *MockLambdaAPI) TagResource(arg0 *lambda.TagResourceInput) (*lambda.TagResourceOutput, error) {
-	ret := m.ctrl.Call(m, "TagResource", arg0)
+func (_m *MockLambdaAPI) TagResource(_param0 *lambda.TagResourceInput) (*lambda.TagResourceOutput, error) {
+	ret := _m.ctrl.Call(_m, "TagResource", _param0)
 	ret0, _ := ret[0].(*lambda.TagResourceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // TagResource indicates an expected call of TagResource
-func (mr *MockLambdaAPIMockRecorder) TagResource(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResource", reflect.TypeOf((*MockLambdaAPI)(nil).TagResource), arg0)
+func (_mr *MockLambdaAPIMockRecorder) TagResource(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "TagResource", reflect.TypeOf((*MockLambdaAPI)(nil).TagResource), arg0)
 }
 
 // TagResourceRequest mocks base method
-func (m *MockLambdaAPI) TagResourceRequest(arg0 *lambda.TagResourceInput) (*request.Request, *lambda.TagResourceOutput) {
-	ret := m.ctrl.Call(m, "TagResourceRequest", arg0)
+func (_m *MockLambdaAPI) TagResourceRequest(_param0 *lambda.TagResourceInput) (*request.Request, *lambda.TagResourceOutput) {
+	ret := _m.ctrl.Call(_m, "TagResourceRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.TagResourceOutput)
 	return ret0, ret1
 }
 
 // TagResourceRequest indicates an expected call of TagResourceRequest
-func (mr *MockLambdaAPIMockRecorder) TagResourceRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceRequest", reflect.TypeOf((*MockLambdaAPI)(nil).TagResourceRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) TagResourceRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "TagResourceRequest", reflect.TypeOf((*MockLambdaAPI)(nil).TagResourceRequest), arg0)
 }
 
 // TagResourceWithContext mocks base method
-func (m *MockLambdaAPI) TagResourceWithContext(arg0 aws.Context, arg1 *lambda.TagResourceInput, arg2 ...request.Option) (*lambda.TagResourceOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) TagResourceWithContext(_param0 context.Context, _param1 *lambda.TagResourceInput, _param2 ...request.Option) (*lambda.TagResourceOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "TagResourceWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "TagResourceWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.TagResourceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // TagResourceWithContext indicates an expected call of TagResourceWithContext
-func (mr *MockLambdaAPIMockRecorder) TagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).TagResourceWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) TagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "TagResourceWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).TagResourceWithContext), _s...)
 }
 
 // UntagResource mocks base method
-func (m *MockLambdaAPI) UntagResource(arg0 *lambda.UntagResourceInput) (*lambda.UntagResourceOutput, error) {
-	ret := m.ctrl.Call(m, "UntagResource", arg0)
+func (_m *MockLambdaAPI) UntagResource(_param0 *lambda.UntagResourceInput) (*lambda.UntagResourceOutput, error) {
+	ret := _m.ctrl.Call(_m, "UntagResource", _param0)
 	ret0, _ := ret[0].(*lambda.UntagResourceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UntagResource indicates an expected call of UntagResource
-func (mr *MockLambdaAPIMockRecorder) UntagResource(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResource", reflect.TypeOf((*MockLambdaAPI)(nil).UntagResource), arg0)
+func (_mr *MockLambdaAPIMockRecorder) UntagResource(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UntagResource", reflect.TypeOf((*MockLambdaAPI)(nil).UntagResource), arg0)
 }
 
 // UntagResourceRequest mocks base method
-func (m *MockLambdaAPI) UntagResourceRequest(arg0 *lambda.UntagResourceInput) (*request.Request, *lambda.UntagResourceOutput) {
-	ret := m.ctrl.Call(m, "UntagResourceRequest", arg0)
+func (_m *MockLambdaAPI) UntagResourceRequest(_param0 *lambda.UntagResourceInput) (*request.Request, *lambda.UntagResourceOutput) {
+	ret := _m.ctrl.Call(_m, "UntagResourceRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.UntagResourceOutput)
 	return ret0, ret1
 }
 
 // UntagResourceRequest indicates an expected call of UntagResourceRequest
-func (mr *MockLambdaAPIMockRecorder) UntagResourceRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UntagResourceRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) UntagResourceRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UntagResourceRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UntagResourceRequest), arg0)
 }
 
 // UntagResourceWithContext mocks base method
-func (m *MockLambdaAPI) UntagResourceWithContext(arg0 aws.Context, arg1 *lambda.UntagResourceInput, arg2 ...request.Option) (*lambda.UntagResourceOutput, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) UntagResourceWithContext(_param0 context.Context, _param1 *lambda.UntagResourceInput, _param2 ...request.Option) (*lambda.UntagResourceOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "UntagResourceWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "UntagResourceWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.UntagResourceOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UntagResourceWithContext indicates an expected call of UntagResourceWithContext
-func (mr *MockLambdaAPIMockRecorder) UntagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UntagResourceWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) UntagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UntagResourceWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UntagResourceWithContext), _s...)
 }
 
 // UpdateAlias mocks base method
-func (m *MockLambdaAPI) UpdateAlias(arg0 *lambda.UpdateAliasInput) (*lambda.AliasConfiguration, error) {
-	ret := m.ctrl.Call(m, "UpdateAlias", arg0)
+func (_m *MockLambdaAPI) UpdateAlias(_param0 *lambda.UpdateAliasInput) (*lambda.AliasConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "UpdateAlias", _param0)
 	ret0, _ := ret[0].(*lambda.AliasConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAlias indicates an expected call of UpdateAlias
-func (mr *MockLambdaAPIMockRecorder) UpdateAlias(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlias", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateAlias), arg0)
+func (_mr *MockLambdaAPIMockRecorder) UpdateAlias(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateAlias", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateAlias), arg0)
 }
 
 // UpdateAliasRequest mocks base method
-func (m *MockLambdaAPI) UpdateAliasRequest(arg0 *lambda.UpdateAliasInput) (*request.Request, *lambda.AliasConfiguration) {
-	ret := m.ctrl.Call(m, "UpdateAliasRequest", arg0)
+func (_m *MockLambdaAPI) UpdateAliasRequest(_param0 *lambda.UpdateAliasInput) (*request.Request, *lambda.AliasConfiguration) {
+	ret := _m.ctrl.Call(_m, "UpdateAliasRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.AliasConfiguration)
 	return ret0, ret1
 }
 
 // UpdateAliasRequest indicates an expected call of UpdateAliasRequest
-func (mr *MockLambdaAPIMockRecorder) UpdateAliasRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAliasRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateAliasRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) UpdateAliasRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateAliasRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateAliasRequest), arg0)
 }
 
 // UpdateAliasWithContext mocks base method
-func (m *MockLambdaAPI) UpdateAliasWithContext(arg0 aws.Context, arg1 *lambda.UpdateAliasInput, arg2 ...request.Option) (*lambda.AliasConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) UpdateAliasWithContext(_param0 context.Context, _param1 *lambda.UpdateAliasInput, _param2 ...request.Option) (*lambda.AliasConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "UpdateAliasWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "UpdateAliasWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.AliasConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateAliasWithContext indicates an expected call of UpdateAliasWithContext
-func (mr *MockLambdaAPIMockRecorder) UpdateAliasWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAliasWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateAliasWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) UpdateAliasWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateAliasWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateAliasWithContext), _s...)
+}
+
+// UpdateCodeSigningConfig mocks base method
+func (_m *MockLambdaAPI) UpdateCodeSigningConfig(_param0 *lambda.UpdateCodeSigningConfigInput) (*lambda.UpdateCodeSigningConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "UpdateCodeSigningConfig", _param0)
+	ret0, _ := ret[0].(*lambda.UpdateCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCodeSigningConfig indicates an expected call of UpdateCodeSigningConfig
+func (_mr *MockLambdaAPIMockRecorder) UpdateCodeSigningConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateCodeSigningConfig", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateCodeSigningConfig), arg0)
+}
+
+// UpdateCodeSigningConfigRequest mocks base method
+func (_m *MockLambdaAPI) UpdateCodeSigningConfigRequest(_param0 *lambda.UpdateCodeSigningConfigInput) (*request.Request, *lambda.UpdateCodeSigningConfigOutput) {
+	ret := _m.ctrl.Call(_m, "UpdateCodeSigningConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.UpdateCodeSigningConfigOutput)
+	return ret0, ret1
+}
+
+// UpdateCodeSigningConfigRequest indicates an expected call of UpdateCodeSigningConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) UpdateCodeSigningConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateCodeSigningConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateCodeSigningConfigRequest), arg0)
+}
+
+// UpdateCodeSigningConfigWithContext mocks base method
+func (_m *MockLambdaAPI) UpdateCodeSigningConfigWithContext(_param0 context.Context, _param1 *lambda.UpdateCodeSigningConfigInput, _param2 ...request.Option) (*lambda.UpdateCodeSigningConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "UpdateCodeSigningConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.UpdateCodeSigningConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCodeSigningConfigWithContext indicates an expected call of UpdateCodeSigningConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) UpdateCodeSigningConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateCodeSigningConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateCodeSigningConfigWithContext), _s...)
 }
 
 // UpdateEventSourceMapping mocks base method
-func (m *MockLambdaAPI) UpdateEventSourceMapping(arg0 *lambda.UpdateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
-	ret := m.ctrl.Call(m, "UpdateEventSourceMapping", arg0)
+func (_m *MockLambdaAPI) UpdateEventSourceMapping(_param0 *lambda.UpdateEventSourceMappingInput) (*lambda.EventSourceMappingConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "UpdateEventSourceMapping", _param0)
 	ret0, _ := ret[0].(*lambda.EventSourceMappingConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateEventSourceMapping indicates an expected call of UpdateEventSourceMapping
-func (mr *MockLambdaAPIMockRecorder) UpdateEventSourceMapping(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventSourceMapping", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateEventSourceMapping), arg0)
+func (_mr *MockLambdaAPIMockRecorder) UpdateEventSourceMapping(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateEventSourceMapping", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateEventSourceMapping), arg0)
 }
 
 // UpdateEventSourceMappingRequest mocks base method
-func (m *MockLambdaAPI) UpdateEventSourceMappingRequest(arg0 *lambda.UpdateEventSourceMappingInput) (*request.Request, *lambda.EventSourceMappingConfiguration) {
-	ret := m.ctrl.Call(m, "UpdateEventSourceMappingRequest", arg0)
+func (_m *MockLambdaAPI) UpdateEventSourceMappingRequest(_param0 *lambda.UpdateEventSourceMappingInput) (*request.Request, *lambda.EventSourceMappingConfiguration) {
+	ret := _m.ctrl.Call(_m, "UpdateEventSourceMappingRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.EventSourceMappingConfiguration)
 	return ret0, ret1
 }
 
 // UpdateEventSourceMappingRequest indicates an expected call of UpdateEventSourceMappingRequest
-func (mr *MockLambdaAPIMockRecorder) UpdateEventSourceMappingRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventSourceMappingRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateEventSourceMappingRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) UpdateEventSourceMappingRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateEventSourceMappingRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateEventSourceMappingRequest), arg0)
 }
 
 // UpdateEventSourceMappingWithContext mocks base method
-func (m *MockLambdaAPI) UpdateEventSourceMappingWithContext(arg0 aws.Context, arg1 *lambda.UpdateEventSourceMappingInput, arg2 ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) UpdateEventSourceMappingWithContext(_param0 context.Context, _param1 *lambda.UpdateEventSourceMappingInput, _param2 ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "UpdateEventSourceMappingWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "UpdateEventSourceMappingWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.EventSourceMappingConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateEventSourceMappingWithContext indicates an expected call of UpdateEventSourceMappingWithContext
-func (mr *MockLambdaAPIMockRecorder) UpdateEventSourceMappingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventSourceMappingWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateEventSourceMappingWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) UpdateEventSourceMappingWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateEventSourceMappingWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateEventSourceMappingWithContext), _s...)
 }
 
 // UpdateFunctionCode mocks base method
-func (m *MockLambdaAPI) UpdateFunctionCode(arg0 *lambda.UpdateFunctionCodeInput) (*lambda.FunctionConfiguration, error) {
-	ret := m.ctrl.Call(m, "UpdateFunctionCode", arg0)
+func (_m *MockLambdaAPI) UpdateFunctionCode(_param0 *lambda.UpdateFunctionCodeInput) (*lambda.FunctionConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "UpdateFunctionCode", _param0)
 	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateFunctionCode indicates an expected call of UpdateFunctionCode
-func (mr *MockLambdaAPIMockRecorder) UpdateFunctionCode(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFunctionCode", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionCode), arg0)
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionCode(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionCode", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionCode), arg0)
 }
 
 // UpdateFunctionCodeRequest mocks base method
-func (m *MockLambdaAPI) UpdateFunctionCodeRequest(arg0 *lambda.UpdateFunctionCodeInput) (*request.Request, *lambda.FunctionConfiguration) {
-	ret := m.ctrl.Call(m, "UpdateFunctionCodeRequest", arg0)
+func (_m *MockLambdaAPI) UpdateFunctionCodeRequest(_param0 *lambda.UpdateFunctionCodeInput) (*request.Request, *lambda.FunctionConfiguration) {
+	ret := _m.ctrl.Call(_m, "UpdateFunctionCodeRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.FunctionConfiguration)
 	return ret0, ret1
 }
 
 // UpdateFunctionCodeRequest indicates an expected call of UpdateFunctionCodeRequest
-func (mr *MockLambdaAPIMockRecorder) UpdateFunctionCodeRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFunctionCodeRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionCodeRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionCodeRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionCodeRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionCodeRequest), arg0)
 }
 
 // UpdateFunctionCodeWithContext mocks base method
-func (m *MockLambdaAPI) UpdateFunctionCodeWithContext(arg0 aws.Context, arg1 *lambda.UpdateFunctionCodeInput, arg2 ...request.Option) (*lambda.FunctionConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) UpdateFunctionCodeWithContext(_param0 context.Context, _param1 *lambda.UpdateFunctionCodeInput, _param2 ...request.Option) (*lambda.FunctionConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "UpdateFunctionCodeWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "UpdateFunctionCodeWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateFunctionCodeWithContext indicates an expected call of UpdateFunctionCodeWithContext
-func (mr *MockLambdaAPIMockRecorder) UpdateFunctionCodeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFunctionCodeWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionCodeWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionCodeWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionCodeWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionCodeWithContext), _s...)
 }
 
 // UpdateFunctionConfiguration mocks base method
-func (m *MockLambdaAPI) UpdateFunctionConfiguration(arg0 *lambda.UpdateFunctionConfigurationInput) (*lambda.FunctionConfiguration, error) {
-	ret := m.ctrl.Call(m, "UpdateFunctionConfiguration", arg0)
+func (_m *MockLambdaAPI) UpdateFunctionConfiguration(_param0 *lambda.UpdateFunctionConfigurationInput) (*lambda.FunctionConfiguration, error) {
+	ret := _m.ctrl.Call(_m, "UpdateFunctionConfiguration", _param0)
 	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateFunctionConfiguration indicates an expected call of UpdateFunctionConfiguration
-func (mr *MockLambdaAPIMockRecorder) UpdateFunctionConfiguration(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFunctionConfiguration", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionConfiguration), arg0)
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionConfiguration(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionConfiguration", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionConfiguration), arg0)
 }
 
 // UpdateFunctionConfigurationRequest mocks base method
-func (m *MockLambdaAPI) UpdateFunctionConfigurationRequest(arg0 *lambda.UpdateFunctionConfigurationInput) (*request.Request, *lambda.FunctionConfiguration) {
-	ret := m.ctrl.Call(m, "UpdateFunctionConfigurationRequest", arg0)
+func (_m *MockLambdaAPI) UpdateFunctionConfigurationRequest(_param0 *lambda.UpdateFunctionConfigurationInput) (*request.Request, *lambda.FunctionConfiguration) {
+	ret := _m.ctrl.Call(_m, "UpdateFunctionConfigurationRequest", _param0)
 	ret0, _ := ret[0].(*request.Request)
 	ret1, _ := ret[1].(*lambda.FunctionConfiguration)
 	return ret0, ret1
 }
 
 // UpdateFunctionConfigurationRequest indicates an expected call of UpdateFunctionConfigurationRequest
-func (mr *MockLambdaAPIMockRecorder) UpdateFunctionConfigurationRequest(arg0 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFunctionConfigurationRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionConfigurationRequest), arg0)
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionConfigurationRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionConfigurationRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionConfigurationRequest), arg0)
 }
 
 // UpdateFunctionConfigurationWithContext mocks base method
-func (m *MockLambdaAPI) UpdateFunctionConfigurationWithContext(arg0 aws.Context, arg1 *lambda.UpdateFunctionConfigurationInput, arg2 ...request.Option) (*lambda.FunctionConfiguration, error) {
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
+func (_m *MockLambdaAPI) UpdateFunctionConfigurationWithContext(_param0 context.Context, _param1 *lambda.UpdateFunctionConfigurationInput, _param2 ...request.Option) (*lambda.FunctionConfiguration, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
 	}
-	ret := m.ctrl.Call(m, "UpdateFunctionConfigurationWithContext", varargs...)
+	ret := _m.ctrl.Call(_m, "UpdateFunctionConfigurationWithContext", _s...)
 	ret0, _ := ret[0].(*lambda.FunctionConfiguration)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateFunctionConfigurationWithContext indicates an expected call of UpdateFunctionConfigurationWithContext
-func (mr *MockLambdaAPIMockRecorder) UpdateFunctionConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFunctionConfigurationWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionConfigurationWithContext), varargs...)
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionConfigurationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionConfigurationWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionConfigurationWithContext), _s...)
+}
+
+// UpdateFunctionEventInvokeConfig mocks base method
+func (_m *MockLambdaAPI) UpdateFunctionEventInvokeConfig(_param0 *lambda.UpdateFunctionEventInvokeConfigInput) (*lambda.UpdateFunctionEventInvokeConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "UpdateFunctionEventInvokeConfig", _param0)
+	ret0, _ := ret[0].(*lambda.UpdateFunctionEventInvokeConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFunctionEventInvokeConfig indicates an expected call of UpdateFunctionEventInvokeConfig
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionEventInvokeConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionEventInvokeConfig", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionEventInvokeConfig), arg0)
+}
+
+// UpdateFunctionEventInvokeConfigRequest mocks base method
+func (_m *MockLambdaAPI) UpdateFunctionEventInvokeConfigRequest(_param0 *lambda.UpdateFunctionEventInvokeConfigInput) (*request.Request, *lambda.UpdateFunctionEventInvokeConfigOutput) {
+	ret := _m.ctrl.Call(_m, "UpdateFunctionEventInvokeConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.UpdateFunctionEventInvokeConfigOutput)
+	return ret0, ret1
+}
+
+// UpdateFunctionEventInvokeConfigRequest indicates an expected call of UpdateFunctionEventInvokeConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionEventInvokeConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionEventInvokeConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionEventInvokeConfigRequest), arg0)
+}
+
+// UpdateFunctionEventInvokeConfigWithContext mocks base method
+func (_m *MockLambdaAPI) UpdateFunctionEventInvokeConfigWithContext(_param0 context.Context, _param1 *lambda.UpdateFunctionEventInvokeConfigInput, _param2 ...request.Option) (*lambda.UpdateFunctionEventInvokeConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "UpdateFunctionEventInvokeConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.UpdateFunctionEventInvokeConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFunctionEventInvokeConfigWithContext indicates an expected call of UpdateFunctionEventInvokeConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionEventInvokeConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionEventInvokeConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionEventInvokeConfigWithContext), _s...)
+}
+
+// UpdateFunctionUrlConfig mocks base method
+func (_m *MockLambdaAPI) UpdateFunctionUrlConfig(_param0 *lambda.UpdateFunctionUrlConfigInput) (*lambda.UpdateFunctionUrlConfigOutput, error) {
+	ret := _m.ctrl.Call(_m, "UpdateFunctionUrlConfig", _param0)
+	ret0, _ := ret[0].(*lambda.UpdateFunctionUrlConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFunctionUrlConfig indicates an expected call of UpdateFunctionUrlConfig
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionUrlConfig(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionUrlConfig", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionUrlConfig), arg0)
+}
+
+// UpdateFunctionUrlConfigRequest mocks base method
+func (_m *MockLambdaAPI) UpdateFunctionUrlConfigRequest(_param0 *lambda.UpdateFunctionUrlConfigInput) (*request.Request, *lambda.UpdateFunctionUrlConfigOutput) {
+	ret := _m.ctrl.Call(_m, "UpdateFunctionUrlConfigRequest", _param0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*lambda.UpdateFunctionUrlConfigOutput)
+	return ret0, ret1
+}
+
+// UpdateFunctionUrlConfigRequest indicates an expected call of UpdateFunctionUrlConfigRequest
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionUrlConfigRequest(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionUrlConfigRequest", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionUrlConfigRequest), arg0)
+}
+
+// UpdateFunctionUrlConfigWithContext mocks base method
+func (_m *MockLambdaAPI) UpdateFunctionUrlConfigWithContext(_param0 context.Context, _param1 *lambda.UpdateFunctionUrlConfigInput, _param2 ...request.Option) (*lambda.UpdateFunctionUrlConfigOutput, error) {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "UpdateFunctionUrlConfigWithContext", _s...)
+	ret0, _ := ret[0].(*lambda.UpdateFunctionUrlConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFunctionUrlConfigWithContext indicates an expected call of UpdateFunctionUrlConfigWithContext
+func (_mr *MockLambdaAPIMockRecorder) UpdateFunctionUrlConfigWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "UpdateFunctionUrlConfigWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).UpdateFunctionUrlConfigWithContext), _s...)
+}
+
+// WaitUntilFunctionActive mocks base method
+func (_m *MockLambdaAPI) WaitUntilFunctionActive(_param0 *lambda.GetFunctionConfigurationInput) error {
+	ret := _m.ctrl.Call(_m, "WaitUntilFunctionActive", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilFunctionActive indicates an expected call of WaitUntilFunctionActive
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilFunctionActive(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilFunctionActive", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilFunctionActive), arg0)
+}
+
+// WaitUntilFunctionActiveV2 mocks base method
+func (_m *MockLambdaAPI) WaitUntilFunctionActiveV2(_param0 *lambda.GetFunctionInput) error {
+	ret := _m.ctrl.Call(_m, "WaitUntilFunctionActiveV2", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilFunctionActiveV2 indicates an expected call of WaitUntilFunctionActiveV2
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilFunctionActiveV2(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilFunctionActiveV2", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilFunctionActiveV2), arg0)
+}
+
+// WaitUntilFunctionActiveV2WithContext mocks base method
+func (_m *MockLambdaAPI) WaitUntilFunctionActiveV2WithContext(_param0 context.Context, _param1 *lambda.GetFunctionInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilFunctionActiveV2WithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilFunctionActiveV2WithContext indicates an expected call of WaitUntilFunctionActiveV2WithContext
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilFunctionActiveV2WithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilFunctionActiveV2WithContext", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilFunctionActiveV2WithContext), _s...)
+}
+
+// WaitUntilFunctionActiveWithContext mocks base method
+func (_m *MockLambdaAPI) WaitUntilFunctionActiveWithContext(_param0 context.Context, _param1 *lambda.GetFunctionConfigurationInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilFunctionActiveWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilFunctionActiveWithContext indicates an expected call of WaitUntilFunctionActiveWithContext
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilFunctionActiveWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilFunctionActiveWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilFunctionActiveWithContext), _s...)
+}
+
+// WaitUntilFunctionExists mocks base method
+func (_m *MockLambdaAPI) WaitUntilFunctionExists(_param0 *lambda.GetFunctionInput) error {
+	ret := _m.ctrl.Call(_m, "WaitUntilFunctionExists", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilFunctionExists indicates an expected call of WaitUntilFunctionExists
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilFunctionExists(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilFunctionExists", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilFunctionExists), arg0)
+}
+
+// WaitUntilFunctionExistsWithContext mocks base method
+func (_m *MockLambdaAPI) WaitUntilFunctionExistsWithContext(_param0 context.Context, _param1 *lambda.GetFunctionInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilFunctionExistsWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilFunctionExistsWithContext indicates an expected call of WaitUntilFunctionExistsWithContext
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilFunctionExistsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilFunctionExistsWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilFunctionExistsWithContext), _s...)
+}
+
+// WaitUntilFunctionUpdated mocks base method
+func (_m *MockLambdaAPI) WaitUntilFunctionUpdated(_param0 *lambda.GetFunctionConfigurationInput) error {
+	ret := _m.ctrl.Call(_m, "WaitUntilFunctionUpdated", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilFunctionUpdated indicates an expected call of WaitUntilFunctionUpdated
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilFunctionUpdated(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilFunctionUpdated", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilFunctionUpdated), arg0)
+}
+
+// WaitUntilFunctionUpdatedV2 mocks base method
+func (_m *MockLambdaAPI) WaitUntilFunctionUpdatedV2(_param0 *lambda.GetFunctionInput) error {
+	ret := _m.ctrl.Call(_m, "WaitUntilFunctionUpdatedV2", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilFunctionUpdatedV2 indicates an expected call of WaitUntilFunctionUpdatedV2
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilFunctionUpdatedV2(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilFunctionUpdatedV2", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilFunctionUpdatedV2), arg0)
+}
+
+// WaitUntilFunctionUpdatedV2WithContext mocks base method
+func (_m *MockLambdaAPI) WaitUntilFunctionUpdatedV2WithContext(_param0 context.Context, _param1 *lambda.GetFunctionInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilFunctionUpdatedV2WithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilFunctionUpdatedV2WithContext indicates an expected call of WaitUntilFunctionUpdatedV2WithContext
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilFunctionUpdatedV2WithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilFunctionUpdatedV2WithContext", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilFunctionUpdatedV2WithContext), _s...)
+}
+
+// WaitUntilFunctionUpdatedWithContext mocks base method
+func (_m *MockLambdaAPI) WaitUntilFunctionUpdatedWithContext(_param0 context.Context, _param1 *lambda.GetFunctionConfigurationInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilFunctionUpdatedWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilFunctionUpdatedWithContext indicates an expected call of WaitUntilFunctionUpdatedWithContext
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilFunctionUpdatedWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilFunctionUpdatedWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilFunctionUpdatedWithContext), _s...)
+}
+
+// WaitUntilPublishedVersionActive mocks base method
+func (_m *MockLambdaAPI) WaitUntilPublishedVersionActive(_param0 *lambda.GetFunctionConfigurationInput) error {
+	ret := _m.ctrl.Call(_m, "WaitUntilPublishedVersionActive", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilPublishedVersionActive indicates an expected call of WaitUntilPublishedVersionActive
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilPublishedVersionActive(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilPublishedVersionActive", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilPublishedVersionActive), arg0)
+}
+
+// WaitUntilPublishedVersionActiveWithContext mocks base method
+func (_m *MockLambdaAPI) WaitUntilPublishedVersionActiveWithContext(_param0 context.Context, _param1 *lambda.GetFunctionConfigurationInput, _param2 ...request.WaiterOption) error {
+	_s := []interface{}{_param0, _param1}
+	for _, _x := range _param2 {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "WaitUntilPublishedVersionActiveWithContext", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilPublishedVersionActiveWithContext indicates an expected call of WaitUntilPublishedVersionActiveWithContext
+func (_mr *MockLambdaAPIMockRecorder) WaitUntilPublishedVersionActiveWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCallWithMethodType(_mr.mock, "WaitUntilPublishedVersionActiveWithContext", reflect.TypeOf((*MockLambdaAPI)(nil).WaitUntilPublishedVersionActiveWithContext), _s...)
 }
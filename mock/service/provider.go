@@ -8,7 +8,12 @@ import (
 	reflect "reflect"
 
 	aws "github.com/aws/aws-sdk-go/aws"
+	cloudwatchiface "github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	cloudwatcheventsiface "github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
 	lambdaiface "github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	s3iface "github.com/aws/aws-sdk-go/service/s3/s3iface"
+	secretsmanageriface "github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	ssmiface "github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -50,3 +55,83 @@ func (m *MockProvideriface) NewService(arg0 *aws.Config) lambdaiface.LambdaAPI {
 func (mr *MockProviderifaceMockRecorder) NewService(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewService", reflect.TypeOf((*MockProvideriface)(nil).NewService), arg0)
 }
+
+// NewS3Service mocks base method
+func (m *MockProvideriface) NewS3Service(arg0 *aws.Config) s3iface.S3API {
+	if m.ctrl == nil {
+		return nil
+	}
+
+	ret := m.ctrl.Call(m, "NewS3Service", arg0)
+	ret0, _ := ret[0].(s3iface.S3API)
+	return ret0
+}
+
+// NewS3Service indicates an expected call of NewS3Service
+func (mr *MockProviderifaceMockRecorder) NewS3Service(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewS3Service", reflect.TypeOf((*MockProvideriface)(nil).NewS3Service), arg0)
+}
+
+// NewCloudWatchEventsService mocks base method
+func (m *MockProvideriface) NewCloudWatchEventsService(arg0 *aws.Config) cloudwatcheventsiface.CloudWatchEventsAPI {
+	if m.ctrl == nil {
+		return nil
+	}
+
+	ret := m.ctrl.Call(m, "NewCloudWatchEventsService", arg0)
+	ret0, _ := ret[0].(cloudwatcheventsiface.CloudWatchEventsAPI)
+	return ret0
+}
+
+// NewCloudWatchEventsService indicates an expected call of NewCloudWatchEventsService
+func (mr *MockProviderifaceMockRecorder) NewCloudWatchEventsService(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCloudWatchEventsService", reflect.TypeOf((*MockProvideriface)(nil).NewCloudWatchEventsService), arg0)
+}
+
+// NewSSMService mocks base method
+func (m *MockProvideriface) NewSSMService(arg0 *aws.Config) ssmiface.SSMAPI {
+	if m.ctrl == nil {
+		return nil
+	}
+
+	ret := m.ctrl.Call(m, "NewSSMService", arg0)
+	ret0, _ := ret[0].(ssmiface.SSMAPI)
+	return ret0
+}
+
+// NewSSMService indicates an expected call of NewSSMService
+func (mr *MockProviderifaceMockRecorder) NewSSMService(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSSMService", reflect.TypeOf((*MockProvideriface)(nil).NewSSMService), arg0)
+}
+
+// NewSecretsManagerService mocks base method
+func (m *MockProvideriface) NewSecretsManagerService(arg0 *aws.Config) secretsmanageriface.SecretsManagerAPI {
+	if m.ctrl == nil {
+		return nil
+	}
+
+	ret := m.ctrl.Call(m, "NewSecretsManagerService", arg0)
+	ret0, _ := ret[0].(secretsmanageriface.SecretsManagerAPI)
+	return ret0
+}
+
+// NewSecretsManagerService indicates an expected call of NewSecretsManagerService
+func (mr *MockProviderifaceMockRecorder) NewSecretsManagerService(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSecretsManagerService", reflect.TypeOf((*MockProvideriface)(nil).NewSecretsManagerService), arg0)
+}
+
+// NewCloudWatchService mocks base method
+func (m *MockProvideriface) NewCloudWatchService(arg0 *aws.Config) cloudwatchiface.CloudWatchAPI {
+	if m.ctrl == nil {
+		return nil
+	}
+
+	ret := m.ctrl.Call(m, "NewCloudWatchService", arg0)
+	ret0, _ := ret[0].(cloudwatchiface.CloudWatchAPI)
+	return ret0
+}
+
+// NewCloudWatchService indicates an expected call of NewCloudWatchService
+func (mr *MockProviderifaceMockRecorder) NewCloudWatchService(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCloudWatchService", reflect.TypeOf((*MockProvideriface)(nil).NewCloudWatchService), arg0)
+}
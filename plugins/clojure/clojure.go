@@ -11,6 +11,7 @@ import (
 
 	"github.com/apex/apex/archive"
 	"github.com/apex/apex/function"
+	"github.com/apex/apex/hooks"
 )
 
 func init() {
@@ -36,12 +37,12 @@ func (p *Plugin) Open(fn *function.Function) error {
 		return nil
 	}
 
-	if fn.Hooks.Build == "" {
-		fn.Hooks.Build = "lein uberjar && mv target/*-standalone.jar target/apex.jar"
+	if len(fn.Hooks.Build) == 0 {
+		fn.Hooks.Build = hooks.Command{"lein uberjar && mv target/*-standalone.jar target/apex.jar"}
 	}
 
-	if fn.Hooks.Clean == "" {
-		fn.Hooks.Clean = "rm -fr target"
+	if len(fn.Hooks.Clean) == 0 {
+		fn.Hooks.Clean = hooks.Command{"rm -fr target"}
 	}
 
 	if _, err := os.Stat(".apexignore"); err != nil {
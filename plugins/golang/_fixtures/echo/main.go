@@ -0,0 +1,23 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+)
+
+// main replies to each request with its event.
+func main() {
+	s := bufio.NewScanner(os.Stdin)
+	enc := json.NewEncoder(os.Stdout)
+
+	for s.Scan() {
+		var req struct {
+			ID    string          `json:"id"`
+			Event json.RawMessage `json:"event"`
+		}
+
+		json.Unmarshal(s.Bytes(), &req)
+		enc.Encode(map[string]interface{}{"id": req.ID, "value": req.Event})
+	}
+}
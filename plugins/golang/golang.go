@@ -2,9 +2,13 @@
 package golang
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/lambda"
+
 	"github.com/apex/apex/function"
+	"github.com/apex/apex/hooks"
 )
 
 func init() {
@@ -14,6 +18,9 @@ func init() {
 const (
 	// Runtime for inference.
 	Runtime = "go1.x"
+
+	// CustomRuntime for architectures other than x86_64, unsupported by go1.x.
+	CustomRuntime = "provided.al2"
 )
 
 // Plugin implementation.
@@ -25,20 +32,37 @@ func (p *Plugin) Open(fn *function.Function) error {
 		return nil
 	}
 
+	arm := fn.Architecture == lambda.ArchitectureArm64
+
 	if fn.Runtime == "golang" {
 		fn.Runtime = Runtime
 	}
 
-	if fn.Hooks.Build == "" {
-		fn.Hooks.Build = "GOOS=linux GOARCH=amd64 go build -o main *.go"
+	if fn.Runtime == Runtime && arm {
+		fn.Runtime = CustomRuntime
+	}
+
+	// custom runtimes execute the "bootstrap" binary
+	binary, goarch := "main", "amd64"
+
+	if fn.Runtime == CustomRuntime {
+		binary = "bootstrap"
+	}
+
+	if arm {
+		goarch = "arm64"
+	}
+
+	if len(fn.Hooks.Build) == 0 {
+		fn.Hooks.Build = hooks.Command{fmt.Sprintf("GOOS=linux GOARCH=%s go build -o %s *.go", goarch, binary)}
 	}
 
 	if fn.Handler == "" {
-		fn.Handler = "main"
+		fn.Handler = binary
 	}
 
-	if fn.Hooks.Clean == "" {
-		fn.Hooks.Clean = "rm -f main"
+	if len(fn.Hooks.Clean) == 0 {
+		fn.Hooks.Clean = hooks.Command{"rm -f " + binary}
 	}
 
 	return nil
@@ -0,0 +1,61 @@
+package golang
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/apex/apex/function"
+)
+
+// binary name of local builds.
+const binary = "_apex_local"
+
+// Run returns the command executing the function compiled for the host, as the
+// deployed binary is built for Linux. It must speak the newline-delimited JSON
+// protocol of the shim, as github.com/apex/go does.
+func (p *Plugin) Run(fn *function.Function, dir string) (*exec.Cmd, error) {
+	if !golang(fn) {
+		return nil, nil
+	}
+
+	path, err := filepath.Abs(filepath.Join(dir, binary))
+	if err != nil {
+		return nil, err
+	}
+
+	if runtime.GOOS == "windows" {
+		path += ".exe"
+	}
+
+	fn.Log.WithField("binary", path).Debug("compiling for local invoke")
+
+	build := exec.Command("go", "build", "-o", path, ".")
+	build.Dir = fn.Path
+	build.Env = append(os.Environ(), "GOOS="+runtime.GOOS, "GOARCH="+runtime.GOARCH)
+
+	if out, err := build.CombinedOutput(); err != nil {
+		return nil, errors.Wrapf(err, "compiling: %s", out)
+	}
+
+	return exec.Command(path), nil
+}
+
+// golang returns true if `fn` is a Go function, either with a go runtime
+// or a custom runtime executing a "bootstrap" binary built from Go sources.
+func golang(fn *function.Function) bool {
+	if strings.HasPrefix(fn.Runtime, "go") {
+		return true
+	}
+
+	if fn.Runtime != CustomRuntime || fn.Handler != "bootstrap" {
+		return false
+	}
+
+	files, _ := filepath.Glob(filepath.Join(fn.Path, "*.go"))
+	return len(files) > 0
+}
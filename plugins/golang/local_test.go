@@ -0,0 +1,56 @@
+package golang_test
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/plugins/golang"
+)
+
+func TestPlugin_Run(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apex-golang")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, runtime := range []string{"go1.x", "provided.al2"} {
+		t.Run(runtime, func(t *testing.T) {
+			fn := &function.Function{
+				Config: function.Config{
+					Runtime: runtime,
+					Handler: "bootstrap",
+				},
+				Path: "_fixtures/echo",
+				Log:  log.Log,
+			}
+
+			cmd, err := (&golang.Plugin{}).Run(fn, dir)
+			assert.NoError(t, err)
+
+			cmd.Stdin = strings.NewReader(`{"id":"1","event":{"value":"tobi"}}` + "\n")
+			out, err := cmd.Output()
+			assert.NoError(t, err)
+			assert.Equal(t, `{"id":"1","value":{"value":"tobi"}}`+"\n", string(out))
+		})
+	}
+
+	t.Run("other custom runtime", func(t *testing.T) {
+		fn := &function.Function{
+			Config: function.Config{
+				Runtime: "provided.al2",
+				Handler: "bootstrap",
+			},
+			Path: dir,
+			Log:  log.Log,
+		}
+
+		cmd, err := (&golang.Plugin{}).Run(fn, dir)
+		assert.NoError(t, err)
+		assert.Nil(t, cmd)
+	})
+}
@@ -2,57 +2,114 @@
 package hooks
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/apex/log"
 
 	"github.com/apex/apex/archive"
 	"github.com/apex/apex/function"
+	"github.com/apex/apex/hooks"
 )
 
 func init() {
 	function.RegisterPlugin("hooks", &Plugin{})
 }
 
+// Output of hook commands, streamed line by line and prefixed with the function name.
+var Output io.Writer = os.Stderr
+
+// outputMu serializes lines of hooks run concurrently.
+var outputMu sync.Mutex
+
 // HookError represents a failed hook command.
 type HookError struct {
-	Hook    string
-	Command string
-	Output  string
+	Hook     string
+	Command  string
+	Output   string
+	ExitCode int
+	Duration time.Duration
+	TimedOut bool
 }
 
 // Error string.
 func (e *HookError) Error() string {
-	return fmt.Sprintf("%s hook: %s", e.Hook, e.Output)
+	d := e.Duration.Round(time.Millisecond)
+
+	if e.TimedOut {
+		return fmt.Sprintf("%s hook: %q timed out after %s: %s", e.Hook, e.Command, d, e.Output)
+	}
+
+	return fmt.Sprintf("%s hook: %q exited with %d after %s: %s", e.Hook, e.Command, e.ExitCode, d, e.Output)
 }
 
 // Plugin implementation.
 type Plugin struct{}
 
+// PreBuild runs the "prebuild" hook commands.
+func (p *Plugin) PreBuild(fn *function.Function) error {
+	return p.run("prebuild", fn.Hooks.PreBuild, fn, fn.HookContext())
+}
+
 // Build runs the "build" hook commands.
 func (p *Plugin) Build(fn *function.Function, zip *archive.Zip) error {
-	return p.run("build", fn.Hooks.Build, fn)
+	return p.run("build", fn.Hooks.Build, fn, fn.HookContext())
+}
+
+// PostBuild runs the "postbuild" hook commands.
+func (p *Plugin) PostBuild(fn *function.Function) error {
+	return p.run("postbuild", fn.Hooks.PostBuild, fn, fn.HookContext())
 }
 
 // Clean runs the "clean" hook commands.
 func (p *Plugin) Clean(fn *function.Function) error {
-	return p.run("clean", fn.Hooks.Clean, fn)
+	return p.run("clean", fn.Hooks.Clean, fn, fn.HookContext())
 }
 
 // Deploy runs the "deploy" hook commands.
 func (p *Plugin) Deploy(fn *function.Function) error {
-	return p.run("deploy", fn.Hooks.Deploy, fn)
+	return p.run("deploy", fn.Hooks.Deploy, fn, fn.HookContext())
 }
 
-// run a hook command.
-func (p *Plugin) run(hook, command string, fn *function.Function) error {
-	if command == "" {
-		return nil
+// PostDeploy runs the "postdeploy" hook commands.
+func (p *Plugin) PostDeploy(fn *function.Function, c *function.HookContext) error {
+	return p.run("postdeploy", fn.Hooks.PostDeploy, fn, c)
+}
+
+// OnFailure runs the "onfailure" hook commands.
+func (p *Plugin) OnFailure(fn *function.Function, c *function.HookContext) error {
+	return p.run("onfailure", fn.Hooks.OnFailure, fn, c)
+}
+
+// Rollback runs the "rollback" hook commands.
+func (p *Plugin) Rollback(fn *function.Function, c *function.HookContext) error {
+	return p.run("rollback", fn.Hooks.Rollback, fn, c)
+}
+
+// run the commands of a hook in order, stopping at the first failure.
+func (p *Plugin) run(hook string, commands hooks.Command, fn *function.Function, c *function.HookContext) error {
+	timeout := time.Duration(fn.Hooks.Timeouts[hook]) * time.Second
+
+	for _, command := range commands {
+		if err := p.exec(hook, command, timeout, fn, env(fn, c)); err != nil {
+			return err
+		}
 	}
 
+	return nil
+}
+
+// exec runs a hook command, streaming its output, and killing
+// its process group when `timeout` is exceeded.
+func (p *Plugin) exec(hook, command string, timeout time.Duration, fn *function.Function, env []string) error {
 	fn.Log.WithFields(log.Fields{
 		"hook":    hook,
 		"command": command,
@@ -64,17 +121,128 @@ func (p *Plugin) run(hook, command string, fn *function.Function) error {
 	} else {
 		cmd = exec.Command("sh", "-c", command)
 	}
-	cmd.Env = os.Environ()
+	cmd.Env = env
 	cmd.Dir = fn.Path
+	setProcessGroup(cmd)
+
+	// commands left running in the background may hold the output open
+	if timeout > 0 {
+		cmd.WaitDelay = time.Second
+	}
+
+	var out bytes.Buffer
+	w := &prefixWriter{prefix: fmt.Sprintf("%s %s | ", fn.Name, hook)}
+	cmd.Stdout = io.MultiWriter(&out, w)
+	cmd.Stderr = cmd.Stdout
 
-	b, err := cmd.CombinedOutput()
-	if err != nil {
+	start := time.Now()
+
+	if err := cmd.Start(); err != nil {
 		return &HookError{
-			Hook:    hook,
-			Command: command,
-			Output:  string(b),
+			Hook:     hook,
+			Command:  command,
+			Output:   err.Error(),
+			ExitCode: -1,
 		}
 	}
 
-	return nil
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	var timer <-chan time.Time
+	if timeout > 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		timer = t.C
+	}
+
+	var err error
+	var timedOut bool
+
+	select {
+	case err = <-done:
+	case <-timer:
+		timedOut = true
+		killProcessGroup(cmd)
+		err = <-done
+	}
+
+	w.Flush()
+
+	if err == nil {
+		return nil
+	}
+
+	code := -1
+	if cmd.ProcessState != nil {
+		code = cmd.ProcessState.ExitCode()
+	}
+
+	return &HookError{
+		Hook:     hook,
+		Command:  command,
+		Output:   strings.TrimSpace(out.String()),
+		ExitCode: code,
+		Duration: time.Since(start),
+		TimedOut: timedOut,
+	}
+}
+
+// env returns the environment of hooks: the process environment, the
+// function's environment variables, and the APEX_ variables of context `c`.
+func env(fn *function.Function, c *function.HookContext) []string {
+	vars := os.Environ()
+
+	var names []string
+	for name := range fn.Environment {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		vars = append(vars, name+"="+fn.Environment[name])
+	}
+
+	return append(vars, c.Env()...)
+}
+
+// prefixWriter writes complete lines to Output with a prefix.
+type prefixWriter struct {
+	prefix string
+	buf    []byte
+}
+
+// Write implementation.
+func (w *prefixWriter) Write(b []byte) (int, error) {
+	w.buf = append(w.buf, b...)
+
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i == -1 {
+			break
+		}
+
+		w.line(w.buf[:i])
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(b), nil
+}
+
+// Flush writes the remaining incomplete line.
+func (w *prefixWriter) Flush() {
+	if len(w.buf) > 0 {
+		w.line(w.buf)
+		w.buf = nil
+	}
+}
+
+// line writes a single prefixed line.
+func (w *prefixWriter) line(b []byte) {
+	outputMu.Lock()
+	defer outputMu.Unlock()
+	fmt.Fprintf(Output, "%s%s\n", w.prefix, b)
 }
@@ -0,0 +1,13 @@
+package hooks
+
+import (
+	"os/exec"
+)
+
+// setProcessGroup is a noop on Windows.
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup kills the process of `cmd`.
+func killProcessGroup(cmd *exec.Cmd) {
+	cmd.Process.Kill()
+}
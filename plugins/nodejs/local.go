@@ -0,0 +1,88 @@
+package nodejs
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/apex/apex/function"
+)
+
+// bootstrap file name.
+const bootstrap = "_apex_local.js"
+
+// Run returns the command invoking the handler with a node bootstrap,
+// which speaks the newline-delimited JSON protocol of the shim.
+func (p *Plugin) Run(fn *function.Function, dir string) (*exec.Cmd, error) {
+	if !strings.HasPrefix(fn.Runtime, "nodejs") {
+		return nil, nil
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, bootstrap), []byte(bootstrapSource), 0644); err != nil {
+		return nil, err
+	}
+
+	heap := fmt.Sprintf("--max-old-space-size=%d", fn.Memory)
+	return exec.Command("node", heap, bootstrap, fn.Handler), nil
+}
+
+// bootstrapSource loads the handler and invokes it for each request read from stdin,
+// writing responses to stdout. Console output is redirected to stderr for the logs.
+const bootstrapSource = `
+var path = require('path');
+var readline = require('readline');
+
+var out = process.stdout;
+console.log = console.info = console.warn = console.error;
+
+var handler = process.argv[2];
+var i = handler.lastIndexOf('.');
+var fn = require(path.resolve(handler.slice(0, i)))[handler.slice(i + 1)];
+
+if (typeof fn !== 'function') {
+  console.error('handler %s is not a function', handler);
+  process.exit(1);
+}
+
+function serialize(err) {
+  if (!(err instanceof Error)) return { errorMessage: String(err) };
+  var stack = (err.stack || '').split('\n').slice(1).map(function(l){ return l.trim() });
+  return { errorMessage: err.message, errorType: err.name, stackTrace: stack };
+}
+
+readline.createInterface({ input: process.stdin }).on('line', function(line){
+  var req = JSON.parse(line);
+  var done = false;
+
+  function respond(err, value) {
+    if (done) return;
+    done = true;
+    var msg = { id: req.id };
+    if (err) msg.error = serialize(err);
+    else msg.value = value === undefined ? null : value;
+    out.write(JSON.stringify(msg) + '\n');
+  }
+
+  var ctx = {
+    functionName: process.env.AWS_LAMBDA_FUNCTION_NAME,
+    functionVersion: process.env.AWS_LAMBDA_FUNCTION_VERSION,
+    memoryLimitInMB: process.env.AWS_LAMBDA_FUNCTION_MEMORY_SIZE,
+    awsRequestId: req.id,
+    clientContext: req.context,
+    callbackWaitsForEmptyEventLoop: true,
+    getRemainingTimeInMillis: function(){ return Math.max(req.deadline - Date.now(), 0) },
+    succeed: function(v){ respond(null, v) },
+    fail: function(e){ respond(e) },
+    done: respond
+  };
+
+  try {
+    var res = fn(req.event, ctx, respond);
+    if (res && typeof res.then === 'function') res.then(function(v){ respond(null, v) }, respond);
+  } catch (err) {
+    respond(err);
+  }
+});
+`
@@ -0,0 +1,83 @@
+package python
+
+import (
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/apex/apex/function"
+)
+
+// bootstrap file name.
+const bootstrap = "_apex_local.py"
+
+// Run returns the command invoking the handler with a python bootstrap,
+// which speaks the newline-delimited JSON protocol of the shim.
+func (p *Plugin) Run(fn *function.Function, dir string) (*exec.Cmd, error) {
+	if !strings.HasPrefix(fn.Runtime, "python") {
+		return nil, nil
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, bootstrap), []byte(bootstrapSource), 0644); err != nil {
+		return nil, err
+	}
+
+	return exec.Command(interpreter(fn.Runtime), "-u", bootstrap, fn.Handler), nil
+}
+
+// interpreter returns the python executable for `runtime`, such as "python3" for "python3.6".
+func interpreter(runtime string) string {
+	if strings.HasPrefix(runtime, "python3") {
+		return "python3"
+	}
+
+	return "python2"
+}
+
+// bootstrapSource loads the handler and invokes it for each request read from stdin,
+// writing responses to stdout. Printed output is redirected to stderr for the logs.
+const bootstrapSource = `
+import importlib, json, os, sys, time, traceback
+
+out = sys.stdout
+sys.stdout = sys.stderr
+sys.path.insert(0, os.getcwd())
+
+module, name = sys.argv[1].rsplit('.', 1)
+handler = getattr(importlib.import_module(module), name)
+
+
+class Context(object):
+    def __init__(self, req):
+        self.function_name = os.environ.get('AWS_LAMBDA_FUNCTION_NAME')
+        self.function_version = os.environ.get('AWS_LAMBDA_FUNCTION_VERSION')
+        self.memory_limit_in_mb = os.environ.get('AWS_LAMBDA_FUNCTION_MEMORY_SIZE')
+        self.aws_request_id = req['id']
+        self.client_context = req.get('context')
+        self.deadline = req['deadline']
+
+    def get_remaining_time_in_millis(self):
+        return max(self.deadline - int(time.time() * 1000), 0)
+
+
+while True:
+    line = sys.stdin.readline()
+    if not line:
+        break
+
+    req = json.loads(line)
+    msg = {'id': req['id']}
+
+    try:
+        msg['value'] = handler(req['event'], Context(req))
+    except Exception as e:
+        msg['error'] = {
+            'errorMessage': str(e),
+            'errorType': type(e).__name__,
+            'stackTrace': [l.strip() for l in traceback.format_tb(sys.exc_info()[2])],
+        }
+
+    out.write(json.dumps(msg) + '\n')
+    out.flush()
+`
@@ -4,7 +4,9 @@ package rust_musl
 import (
 	"fmt"
 	"github.com/apex/apex/function"
+	"github.com/apex/apex/hooks"
 	"github.com/apex/apex/plugins/nodejs"
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"strings"
 )
 
@@ -26,15 +28,21 @@ func (p *Plugin) Open(fn *function.Function) error {
 		return nil
 	}
 
-	if fn.Hooks.Build == "" {
-		fn.Hooks.Build = fmt.Sprintf("cargo build --target=x86_64-unknown-linux-musl --release && mv target/x86_64-unknown-linux-musl/release/%v ./main", fn.Name)
+	if len(fn.Hooks.Build) == 0 {
+		target := "x86_64-unknown-linux-musl"
+
+		if fn.Architecture == lambda.ArchitectureArm64 {
+			target = "aarch64-unknown-linux-musl"
+		}
+
+		fn.Hooks.Build = hooks.Command{fmt.Sprintf("cargo build --target=%s --release && mv target/%s/release/%v ./main", target, target, fn.Name)}
 	}
 
 	fn.Shim = true
 	fn.Runtime = nodejs.Runtime
 
-	if fn.Hooks.Clean == "" {
-		fn.Hooks.Clean = "rm -f main"
+	if len(fn.Hooks.Clean) == 0 {
+		fn.Hooks.Clean = hooks.Command{"rm -f main"}
 	}
 
 	fn.IgnoreFile = append(fn.IgnoreFile, []byte("\ntarget/")...)
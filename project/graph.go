@@ -0,0 +1,316 @@
+package project
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"github.com/tj/go-sync/semaphore"
+
+	"github.com/apex/apex/function"
+)
+
+// references of functions from config templates.
+type references struct {
+	sync.Mutex
+	functions map[string][]*function.Function
+}
+
+// dependencies returns the names of the functions each loaded function
+// depends on, either with dependsOn or by referencing them in its environment,
+// erroring for unknown functions and cycles.
+func (p *Project) dependencies() (map[string][]string, error) {
+	names, err := p.FunctionDirNames()
+	if err != nil {
+		return nil, err
+	}
+
+	deps := make(map[string][]string)
+
+	for _, fns := range p.groups() {
+		fn := fns[0]
+
+		refs, err := referencedNames(fn)
+		if err != nil {
+			return nil, fmt.Errorf("function %s: %s", fn.Name, err)
+		}
+
+		var list []string
+		for _, name := range append(copyStrings(fn.DependsOn), refs...) {
+			if !contains(names, name) {
+				return nil, fmt.Errorf("function %s: depends on unknown function %q", fn.Name, name)
+			}
+
+			if name == fn.Name {
+				return nil, fmt.Errorf("function %s: depends on itself", fn.Name)
+			}
+
+			if !contains(list, name) {
+				list = append(list, name)
+			}
+		}
+
+		sort.Strings(list)
+		deps[fn.Name] = list
+	}
+
+	if cycle := findCycle(deps); cycle != nil {
+		return nil, fmt.Errorf("dependency cycle: %s", strings.Join(cycle, " -> "))
+	}
+
+	return deps, nil
+}
+
+// findCycle returns the names of a dependency cycle, or nil.
+func findCycle(deps map[string][]string) []string {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	state := make(map[string]int)
+	var path []string
+	var cycle []string
+
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		switch state[name] {
+		case visited:
+			return false
+		case visiting:
+			for i, n := range path {
+				if n == name {
+					cycle = append(append(cycle, path[i:]...), name)
+				}
+			}
+			return true
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+
+		for _, dep := range deps[name] {
+			if visit(dep) {
+				return true
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[name] = visited
+		return false
+	}
+
+	var names []string
+	for name := range deps {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if visit(name) {
+			return cycle
+		}
+	}
+
+	return nil
+}
+
+// deployGraph deploys the packaged groups concurrently, up to the project's
+// concurrency, each once the functions it depends on are deployed. Functions
+// depending on failed functions are not deployed. No more functions are
+// started after a failure unless the region failure handling is to continue.
+func (p *Project) deployGraph(groups [][]*function.Function, zips [][]byte, deps map[string][]string) error {
+	sem := make(semaphore.Semaphore, p.Concurrency)
+	done := make(map[string]chan struct{})
+	for _, fns := range groups {
+		done[fns[0].Name] = make(chan struct{})
+	}
+
+	var mu sync.Mutex
+	var errs []error
+	failed := make(map[string]bool)
+
+	var wg sync.WaitGroup
+
+	for i := range groups {
+		i := i
+		name := groups[i][0].Name
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer close(done[name])
+
+			for _, dep := range deps[name] {
+				if ch, ok := done[dep]; ok {
+					<-ch
+				}
+			}
+
+			sem.Acquire()
+			defer sem.Release()
+
+			mu.Lock()
+			var dep string
+			for _, d := range deps[name] {
+				if failed[d] {
+					dep = d
+					break
+				}
+			}
+			stop := len(errs) > 0 && p.RegionFailure != RegionFailureContinue
+			if dep != "" || stop {
+				failed[name] = true
+			}
+			if dep != "" && !stop {
+				errs = append(errs, fmt.Errorf("function %s: not deployed, dependency %s failed", name, dep))
+			}
+			mu.Unlock()
+
+			if dep != "" || stop {
+				return
+			}
+
+			if err := p.deployGroup(groups[i], zips[i]); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				failed[name] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if p.RegionFailure != RegionFailureContinue && len(errs) > 1 {
+		errs = errs[:1]
+	}
+
+	return combine(errs)
+}
+
+// resolveReferences replaces the function references in the environment
+// of `fn`, such as {{ function "worker" "arn" }}, with their values.
+func (p *Project) resolveReferences(fn *function.Function) error {
+	for k, v := range fn.Environment {
+		if !strings.Contains(v, "{{") {
+			continue
+		}
+
+		t, err := template.New(k).Funcs(template.FuncMap{
+			"function": func(name, field string) (string, error) {
+				return p.reference(fn, name, field)
+			},
+		}).Parse(v)
+
+		if err != nil {
+			return errors.Wrapf(err, "parsing environment variable %s", k)
+		}
+
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, nil); err != nil {
+			return errors.Wrapf(err, "resolving environment variable %s", k)
+		}
+
+		fn.Environment[k] = buf.String()
+	}
+
+	return nil
+}
+
+// reference returns the `field` of function `name` referenced by `fn`,
+// using the instance in the same region when deployed to several regions.
+func (p *Project) reference(fn *function.Function, name, field string) (string, error) {
+	fns, err := p.referenced(name)
+	if err != nil {
+		return "", err
+	}
+
+	ref := fns[0]
+	for _, f := range fns {
+		if f.Region == fn.Region {
+			ref = f
+		}
+	}
+
+	switch field {
+	case "name":
+		return ref.FunctionName, nil
+	case "arn":
+		p.references.Lock()
+		defer p.references.Unlock()
+		return ref.AliasARN()
+	default:
+		return "", fmt.Errorf("unknown field %q of function %s, must be arn or name", field, name)
+	}
+}
+
+// referenced returns the instances of function `name`, which are loaded
+// when the function is not deployed, for looking up its deployed ARN.
+func (p *Project) referenced(name string) ([]*function.Function, error) {
+	var fns []*function.Function
+	for _, fn := range p.Functions {
+		if fn.Name == name {
+			fns = append(fns, fn)
+		}
+	}
+
+	if len(fns) > 0 {
+		return fns, nil
+	}
+
+	p.references.Lock()
+	defer p.references.Unlock()
+
+	if fns, ok := p.references.functions[name]; ok {
+		return fns, nil
+	}
+
+	fns, err := p.loadFunctionRegions(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading %s", name)
+	}
+
+	if p.references.functions == nil {
+		p.references.functions = make(map[string][]*function.Function)
+	}
+
+	p.references.functions[name] = fns
+	return fns, nil
+}
+
+// referencedNames returns the names of the functions referenced in the environment of `fn`.
+func referencedNames(fn *function.Function) ([]string, error) {
+	var names []string
+
+	for k, v := range fn.Environment {
+		if !strings.Contains(v, "{{") {
+			continue
+		}
+
+		t, err := template.New(k).Funcs(template.FuncMap{
+			"function": func(name, field string) string {
+				if !contains(names, name) {
+					names = append(names, name)
+				}
+				return ""
+			},
+		}).Parse(v)
+
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing environment variable %s", k)
+		}
+
+		if err := t.Execute(&bytes.Buffer{}, nil); err != nil {
+			return nil, errors.Wrapf(err, "environment variable %s", k)
+		}
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
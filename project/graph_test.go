@@ -0,0 +1,117 @@
+package project_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/mock"
+	"github.com/apex/apex/mock/service"
+	"github.com/apex/apex/project"
+)
+
+func TestProject_Deploy_dependencies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+	mockProvider.EXPECT().NewService(nil).Return(serviceMock).Times(2)
+
+	var mu sync.Mutex
+	var created []string
+	env := make(map[string]map[string]*string)
+
+	serviceMock.EXPECT().GetFunction(gomock.Any()).Return(nil, awserr.New("ResourceNotFoundException", "not found", nil)).Times(2)
+	for _, name := range []string{"dependencies_worker", "dependencies_api"} {
+		name := name
+		serviceMock.EXPECT().CreateFunction(createFunction(name)).Do(func(in *lambda.CreateFunctionInput) {
+			mu.Lock()
+			defer mu.Unlock()
+			created = append(created, name)
+			env[name] = in.Environment.Variables
+		}).Return(&lambda.FunctionConfiguration{
+			CodeSha256:  aws.String("sha"),
+			FunctionArn: aws.String("arn:aws:lambda:us-west-2:123456789012:function:" + name),
+		}, nil)
+	}
+	serviceMock.EXPECT().WaitUntilFunctionActive(gomock.Any()).Times(2)
+	serviceMock.EXPECT().PublishVersion(gomock.Any()).Return(&lambda.FunctionConfiguration{Version: aws.String("1")}, nil).Times(2)
+	serviceMock.EXPECT().CreateAlias(gomock.Any()).Times(2)
+
+	p := &project.Project{
+		Path:            "_fixtures/dependencies",
+		Log:             log.Log,
+		ServiceProvider: mockProvider,
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	p.BuildCache = ""
+	assert.NoError(t, p.LoadFunctions(), "load")
+	assert.NoError(t, p.Deploy(), "deploy")
+
+	assert.Equal(t, []string{"dependencies_worker", "dependencies_api"}, created)
+	assert.Equal(t, "arn:aws:lambda:us-west-2:123456789012:function:dependencies_worker:current", *env["dependencies_api"]["WORKER_ARN"])
+	assert.Equal(t, "dependencies_worker", *env["dependencies_api"]["WORKER_NAME"])
+}
+
+func TestProject_Deploy_dependencyFailed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+	mockProvider.EXPECT().NewService(nil).Return(serviceMock).Times(2)
+
+	serviceMock.EXPECT().GetFunction(&lambda.GetFunctionInput{FunctionName: aws.String("dependencies_worker")}).Return(nil, errors.New("API err"))
+
+	p := &project.Project{
+		Path:            "_fixtures/dependencies",
+		Log:             log.Log,
+		ServiceProvider: mockProvider,
+		RegionFailure:   project.RegionFailureContinue,
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	p.BuildCache = ""
+	assert.NoError(t, p.LoadFunctions(), "load")
+	assert.EqualError(t, p.Deploy(), `function api: not deployed, dependency worker failed
+function worker: API err`)
+}
+
+func TestProject_Validate_dependencyCycle(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
+	mockProvider.EXPECT().NewService(nil).Times(2)
+
+	p := &project.Project{
+		Path:            "_fixtures/dependencyCycle",
+		Log:             log.Log,
+		ServiceProvider: mockProvider,
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	assert.NoError(t, p.LoadFunctions(), "load")
+	assert.EqualError(t, p.Validate(false), "dependency cycle: a -> b -> a")
+}
+
+// createFunction matches CreateFunction input of the function `name`.
+type createFunction string
+
+func (m createFunction) Matches(x interface{}) bool {
+	in, ok := x.(*lambda.CreateFunctionInput)
+	return ok && *in.FunctionName == string(m)
+}
+
+func (m createFunction) String() string {
+	return "creates function " + string(m)
+}
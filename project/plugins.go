@@ -0,0 +1,78 @@
+package project
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/apex/apex/function"
+)
+
+// Plugins config, changing the plugins functions use. Plugins which are not
+// built in are run as executables, either declared in Commands or discovered
+// in PATH as apex-plugin-<name>.
+type Plugins struct {
+	// Order of the plugins, replacing the default plugins.
+	Order []string `json:"order"`
+
+	// Enable plugins after the default or ordered plugins.
+	Enable []string `json:"enable"`
+
+	// Disable plugins.
+	Disable []string `json:"disable"`
+
+	// Commands of external plugins by name, relative to the project.
+	// Declared plugins are enabled unless disabled.
+	Commands map[string]string `json:"commands"`
+}
+
+// plugins returns the names of the plugins functions use, in order.
+func (p *Project) plugins() []string {
+	names := p.Plugins.Order
+	if names == nil {
+		names = function.DefaultPlugins()
+	}
+
+	enable := p.Plugins.Enable
+
+	var declared []string
+	for name := range p.Plugins.Commands {
+		declared = append(declared, name)
+	}
+
+	sort.Strings(declared)
+	enable = append(enable, declared...)
+
+	var list []string
+	for _, name := range append(copyStrings(names), enable...) {
+		if !contains(list, name) && !contains(p.Plugins.Disable, name) {
+			list = append(list, name)
+		}
+	}
+
+	return list
+}
+
+// registerPlugins registers the external plugins declared in the project.
+func (p *Project) registerPlugins() {
+	for name, command := range p.Plugins.Commands {
+		if strings.ContainsRune(command, filepath.Separator) && !filepath.IsAbs(command) {
+			if abs, err := filepath.Abs(filepath.Join(p.Path, command)); err == nil {
+				command = abs
+			}
+		}
+
+		function.RegisterExternalPlugin(name, command)
+	}
+}
+
+// contains returns true if `s` is in `list`.
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
+	"os/user"
 	"path/filepath"
+	"strings"
 	"text/template"
 
 	"github.com/apex/log"
@@ -19,6 +22,7 @@ import (
 	"github.com/apex/apex/function"
 	"github.com/apex/apex/hooks"
 	"github.com/apex/apex/infra"
+	"github.com/apex/apex/layer"
 	"github.com/apex/apex/service"
 	"github.com/apex/apex/utils"
 	"github.com/apex/apex/vpc"
@@ -37,6 +41,9 @@ const (
 
 	// functions directory
 	functionsDir = "functions"
+
+	// layers directory
+	layersDir = "layers"
 )
 
 // Config for project.
@@ -56,6 +63,14 @@ type Config struct {
 	Hooks              hooks.Hooks       `json:"hooks"`
 	VPC                vpc.VPC           `json:"vpc"`
 	Zip                string            `json:"zip"`
+	S3Bucket           string            `json:"s3Bucket"`
+	S3Prefix           string            `json:"s3Prefix"`
+	Layers             []string          `json:"layers"`
+	Architecture       string            `json:"architecture"`
+	Regions            []string          `json:"regions"`
+	CacheBuilds        bool              `json:"cacheBuilds"`
+	RegionFailure      string            `json:"regionFailure" validate:"regexp=^(stop|continue)?$"`
+	Plugins            Plugins           `json:"plugins"`
 }
 
 // Project represents zero or more Lambda functions.
@@ -63,7 +78,10 @@ type Project struct {
 	Config
 	Path             string
 	Alias            string
+	Canary           *function.Canary
+	BuildCache       string
 	Concurrency      int
+	DryRun           bool
 	Environment      string
 	InfraEnvironment string
 	Log              log.Interface
@@ -71,6 +89,7 @@ type Project struct {
 	Functions        []*function.Function
 	IgnoreFile       []byte
 	nameTemplate     *template.Template
+	references       references
 }
 
 // defaults applies configuration defaults.
@@ -100,24 +119,36 @@ func (p *Project) defaults() {
 func (p *Project) Open() error {
 	p.defaults()
 
-	configFile := "project.json"
+	// project.ENV.json is an overlay of project.json, which
+	// is optional when the environment's config exists
+	paths := []string{filepath.Join(p.Path, "project.json")}
 	if p.Environment != "" {
-		configFile = fmt.Sprintf("project.%s.json", p.Environment)
+		paths = append(paths, filepath.Join(p.Path, fmt.Sprintf("project.%s.json", p.Environment)))
 	}
 
-	f, err := os.Open(filepath.Join(p.Path, configFile))
-	if err != nil {
+	if _, err := os.Stat(paths[len(paths)-1]); err != nil {
 		return err
 	}
 
-	if err := json.NewDecoder(f).Decode(&p.Config); err != nil {
+	if _, err := utils.LoadConfig(&p.Config, paths...); err != nil {
 		return err
 	}
 
+	if p.Config.Environment == nil {
+		p.Config.Environment = make(map[string]string)
+	}
+
 	if p.InfraEnvironment == "" {
 		p.InfraEnvironment = p.Config.DefaultEnvironment
 	}
 
+	// the build cache is opt-in, as build hooks may read inputs outside of the cache key
+	if p.CacheBuilds {
+		if dir, err := os.UserCacheDir(); err == nil {
+			p.BuildCache = filepath.Join(dir, "apex", "builds")
+		}
+	}
+
 	if p.Role == "" {
 		p.Role = p.readInfraRole()
 	}
@@ -132,6 +163,8 @@ func (p *Project) Open() error {
 	}
 	p.nameTemplate = t
 
+	p.registerPlugins()
+
 	ignoreFile, err := utils.ReadIgnoreFile(p.Path)
 	if err != nil {
 		return err
@@ -162,12 +195,12 @@ func (p *Project) LoadFunctions(patterns ...string) error {
 			continue
 		}
 
-		fn, err := p.LoadFunction(name)
+		fns, err := p.loadFunctionRegions(name)
 		if err != nil {
 			return errors.Wrapf(err, "loading %s", name)
 		}
 
-		p.Functions = append(p.Functions, fn)
+		p.Functions = append(p.Functions, fns...)
 	}
 
 	if len(p.Functions) == 0 {
@@ -186,22 +219,89 @@ func (p *Project) DeployAndClean() error {
 	return p.Clean()
 }
 
-// Deploy functions and their configurations.
+// Deploy functions and their configurations, and the layers they reference.
+// Every function is validated and packaged before any is deployed.
 func (p *Project) Deploy() error {
+	if err := p.Validate(false); err != nil {
+		return err
+	}
+
+	deps, err := p.dependencies()
+	if err != nil {
+		return err
+	}
+
+	groups := p.groups()
+
+	zips, err := p.packageGroups(groups)
+	if err != nil {
+		return err
+	}
+
+	if err := p.resolveLayers(deployLayer); err != nil {
+		return err
+	}
+
 	p.Log.Debugf("deploying %d functions", len(p.Functions))
 
+	p.connectSecrets()
+
+	release := p.release()
+	for _, fn := range p.Functions {
+		fn.Release = release
+	}
+
+	return p.deployGraph(groups, zips, deps)
+}
+
+// Diff returns the differences between the local and deployed functions.
+func (p *Project) Diff() ([]*function.Diff, error) {
+	if err := p.resolveLayers(latestLayer); err != nil {
+		return nil, err
+	}
+
+	p.connectSecrets()
+
+	p.Log.Debugf("diffing %d functions", len(p.Functions))
+
+	var diffs []*function.Diff
+
+	for _, fn := range p.Functions {
+		if err := p.resolveReferences(fn); err != nil {
+			return nil, fmt.Errorf("function %s: %s", fn.Name, err)
+		}
+
+		d, err := fn.Diff()
+		if err != nil {
+			return nil, fmt.Errorf("function %s: %s", fn.Name, err)
+		}
+
+		diffs = append(diffs, d)
+	}
+
+	return diffs, nil
+}
+
+// Build functions concurrently, writing their zips to `dir` as <name>.zip.
+func (p *Project) Build(dir string) error {
+	p.Log.Debugf("building %d functions", len(p.Functions))
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
 	sem := make(semaphore.Semaphore, p.Concurrency)
 	errs := make(chan error)
 
 	go func() {
-		for _, fn := range p.Functions {
-			fn := fn
+		for _, fns := range p.groups() {
+			fn := fns[0]
 			sem.Acquire()
 
 			go func() {
 				defer sem.Release()
 
-				err := fn.Deploy()
+				err := buildTo(fn, filepath.Join(dir, fn.Name+".zip"))
 				if err != nil {
 					err = fmt.Errorf("function %s: %s", fn.Name, err)
 				}
@@ -214,20 +314,37 @@ func (p *Project) Deploy() error {
 		close(errs)
 	}()
 
+	var failed error
 	for err := range errs {
-		if err != nil {
-			return err
+		if err != nil && failed == nil {
+			failed = err
 		}
 	}
 
-	return nil
+	return failed
+}
+
+// buildTo builds `fn` and writes its zip to `path`.
+func buildTo(fn *function.Function, path string) error {
+	zip, err := fn.BuildBytes()
+	if err != nil {
+		return err
+	}
+
+	if err := fn.Clean(); err != nil {
+		return err
+	}
+
+	fn.Log.WithField("path", path).Info("build written")
+	return ioutil.WriteFile(path, zip, 0644)
 }
 
 // Clean up function build artifacts.
 func (p *Project) Clean() error {
 	p.Log.Debugf("cleaning %d functions", len(p.Functions))
 
-	for _, fn := range p.Functions {
+	for _, fns := range p.groups() {
+		fn := fns[0]
 		if err := fn.Clean(); err != nil {
 			return fmt.Errorf("function %s: %s", fn.Name, err)
 		}
@@ -261,26 +378,54 @@ func (p *Project) Delete() error {
 func (p *Project) Rollback() error {
 	p.Log.Debugf("rolling back %d functions", len(p.Functions))
 
-	for _, fn := range p.Functions {
-		if err := fn.Rollback(); err != nil {
-			return fmt.Errorf("function %s: %s", fn.Name, err)
-		}
-	}
-
-	return nil
+	return p.each(len(p.Functions), func(i int) error {
+		fn := p.Functions[i]
+		return functionError(fn, fn.Rollback())
+	})
 }
 
 // RollbackVersion project functions to the specified version.
 func (p *Project) RollbackVersion(version string) error {
 	p.Log.Debugf("rolling back %d functions to version %s", len(p.Functions), version)
 
-	for _, fn := range p.Functions {
-		if err := fn.RollbackVersion(version); err != nil {
-			return fmt.Errorf("function %s: %s", fn.Name, err)
-		}
+	return p.each(len(p.Functions), func(i int) error {
+		fn := p.Functions[i]
+		return functionError(fn, fn.RollbackVersion(version))
+	})
+}
+
+// RollbackCommit project functions to the versions released from the specified git commit.
+func (p *Project) RollbackCommit(commit string) error {
+	p.Log.Debugf("rolling back %d functions to commit %s", len(p.Functions), commit)
+
+	return p.each(len(p.Functions), func(i int) error {
+		fn := p.Functions[i]
+		return functionError(fn, fn.RollbackCommit(commit))
+	})
+}
+
+// release returns the release recorded in the versions published by deploys,
+// with the current git commit and user when available.
+func (p *Project) release() *function.Release {
+	r := &function.Release{
+		Environment: p.Environment,
+		User:        os.Getenv("USER"),
 	}
 
-	return nil
+	if u, err := user.Current(); err == nil {
+		r.User = u.Username
+	}
+
+	cmd := exec.Command("git", "rev-parse", "HEAD")
+	cmd.Dir = p.Path
+
+	if b, err := cmd.Output(); err == nil {
+		r.Commit = strings.TrimSpace(string(b))
+	} else {
+		p.Log.Debugf("resolving git commit: %s", err)
+	}
+
+	return r
 }
 
 // FunctionDirNames returns a list of function directory names.
@@ -337,6 +482,17 @@ func (p *Project) LoadFunction(name string) (*function.Function, error) {
 
 // LoadFunctionByPath returns the function in the given directory.
 func (p *Project) LoadFunctionByPath(name, path string) (*function.Function, error) {
+	fn, err := p.openFunction(name, path)
+	if err != nil {
+		return nil, err
+	}
+
+	p.connect(fn)
+	return fn, nil
+}
+
+// openFunction returns the function in the given directory, without its services.
+func (p *Project) openFunction(name, path string) (*function.Function, error) {
 	fn := &function.Function{
 		Config: function.Config{
 			Runtime:          p.Runtime,
@@ -350,12 +506,21 @@ func (p *Project) LoadFunctionByPath(name, path string) (*function.Function, err
 			RetainedVersions: p.RetainedVersions,
 			VPC:              copyVPC(p.VPC),
 			Zip:              p.Zip,
+			S3Bucket:         p.S3Bucket,
+			S3Prefix:         p.S3Prefix,
+			Layers:           copyStrings(p.Layers),
+			Architecture:     p.Architecture,
+			Regions:          copyStrings(p.Regions),
 		},
 		Name:       name,
 		Path:       path,
 		Log:        p.Log,
 		IgnoreFile: p.IgnoreFile,
+		Plugins:    p.plugins(),
 		Alias:      p.Alias,
+		Canary:     p.Canary,
+		BuildCache: p.BuildCache,
+		DryRun:     p.DryRun,
 	}
 
 	if name, err := p.name(fn); err == nil {
@@ -368,51 +533,147 @@ func (p *Project) LoadFunctionByPath(name, path string) (*function.Function, err
 		return nil, err
 	}
 
+	return fn, nil
+}
+
+// connect provides the services of `fn` in its region.
+func (p *Project) connect(fn *function.Function) {
 	fn.Service = p.ServiceProvider.NewService(fn.AWSConfig())
 
-	return fn, nil
+	if fn.S3Bucket != "" {
+		if config := fn.AWSConfig(); config != nil {
+			fn.S3Bucket = strings.Replace(fn.S3Bucket, function.BucketRegion, aws.StringValue(config.Region), -1)
+		}
+
+		fn.S3 = p.ServiceProvider.NewS3Service(fn.AWSConfig())
+	}
+
+	// triggers removed from the config are reconciled to none
+	fn.Events = p.ServiceProvider.NewCloudWatchEventsService(fn.AWSConfig())
+
+	// canary metrics are reported in the region of the function
+	if fn.Canary != nil {
+		canary := *fn.Canary
+		canary.Metrics = p.ServiceProvider.NewCloudWatchService(fn.AWSConfig())
+		fn.Canary = &canary
+	}
 }
 
-// CreateOrUpdateAlias ensures the given `alias` is available for `version`.
-func (p *Project) CreateOrUpdateAlias(alias, version string) error {
-	p.Log.Debugf("updating %d functions", len(p.Functions))
+// connectSecrets provides the secret services to functions referencing secrets
+// in their environment, which is only complete once flags have been applied.
+func (p *Project) connectSecrets() {
+	for _, fn := range p.Functions {
+		if fn.HasSecrets() {
+			fn.SSM = p.ServiceProvider.NewSSMService(fn.AWSConfig())
+			fn.SecretsManager = p.ServiceProvider.NewSecretsManagerService(fn.AWSConfig())
+		}
+	}
+}
 
-	sem := make(semaphore.Semaphore, p.Concurrency)
-	errs := make(chan error)
+// LoadLayer returns the layer in the ./layers/<name> directory.
+func (p *Project) LoadLayer(name string) (*layer.Layer, error) {
+	return p.loadLayer(name, nil)
+}
 
-	go func() {
-		for _, fn := range p.Functions {
-			fn := fn
-			sem.Acquire()
+// loadLayer returns the layer in the ./layers/<name> directory, published with AWS `config`.
+func (p *Project) loadLayer(name string, config *aws.Config) (*layer.Layer, error) {
+	path := filepath.Join(p.Path, layersDir, name)
 
-			go func() {
-				defer sem.Release()
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+
+	l := &layer.Layer{
+		Name:       name,
+		LayerName:  fmt.Sprintf("%s_%s", p.Name, name),
+		Path:       path,
+		Log:        p.Log,
+		IgnoreFile: p.IgnoreFile,
+		Service:    p.ServiceProvider.NewService(config),
+	}
+
+	if err := l.Open(); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// resolveLayers replaces references to project layers in function
+// configurations with the layer version ARN returned by `resolve`,
+// layers being published in the region of each function using them.
+func (p *Project) resolveLayers(resolve func(*layer.Layer) (string, error)) error {
+	arns := make(map[string]string)
 
-				version, err := fn.GetVersionFromAlias(version)
+	for _, fn := range p.Functions {
+		for i, name := range fn.Layers {
+			if strings.HasPrefix(name, "arn:") {
+				continue
+			}
+
+			config := fn.AWSConfig()
+
+			key := name
+			if config != nil {
+				key = aws.StringValue(config.Region) + "/" + name
+			}
+
+			arn, ok := arns[key]
+			if !ok {
+				l, err := p.loadLayer(name, config)
 				if err != nil {
-					err = fmt.Errorf("function %s: %s", fn.Name, err)
+					return fmt.Errorf("layer %s: %s", name, err)
 				}
 
-				err = fn.CreateOrUpdateAlias(alias, version)
+				arn, err = resolve(l)
 				if err != nil {
-					err = fmt.Errorf("function %s: %s", fn.Name, err)
+					return fmt.Errorf("layer %s: %s", name, err)
 				}
 
-				errs <- err
-			}()
+				arns[key] = arn
+			}
+
+			fn.Layers[i] = arn
 		}
+	}
 
-		sem.Wait()
-		close(errs)
-	}()
+	return nil
+}
 
-	for err := range errs {
+// deployLayer publishes the layer when changed.
+func deployLayer(l *layer.Layer) (string, error) {
+	return l.Deploy()
+}
+
+// latestLayer returns the latest published layer version, or
+// the layer name when it hasn't been published yet.
+func latestLayer(l *layer.Layer) (string, error) {
+	v, err := l.Latest()
+	if err != nil {
+		return "", err
+	}
+
+	if v == nil {
+		return l.LayerName, nil
+	}
+
+	return *v.LayerVersionArn, nil
+}
+
+// CreateOrUpdateAlias ensures the given `alias` is available for `version`.
+func (p *Project) CreateOrUpdateAlias(alias, version string) error {
+	p.Log.Debugf("updating %d functions", len(p.Functions))
+
+	return p.each(len(p.Functions), func(i int) error {
+		fn := p.Functions[i]
+
+		version, err := fn.GetVersionFromAlias(version)
 		if err != nil {
-			return err
+			return functionError(fn, err)
 		}
-	}
 
-	return nil
+		return functionError(fn, fn.CreateOrUpdateAlias(alias, version))
+	})
 }
 
 // name returns the computed name for `fn`, using the nameTemplate.
@@ -464,6 +725,17 @@ func copyStringMap(in map[string]string) map[string]string {
 	return out
 }
 
+// copyStrings returns a copy of `in`.
+func copyStrings(in []string) []string {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]string, len(in))
+	copy(out, in)
+	return out
+}
+
 // copyVPC returns a copy of `in`.
 func copyVPC(in vpc.VPC) vpc.VPC {
 	securityGroups := make([]string, len(in.SecurityGroups))
@@ -33,6 +33,16 @@ func TestProject_Open_requireConfigValues(t *testing.T) {
 	assert.Contains(t, nameErr.Error(), "Name: zero value")
 }
 
+func TestProject_Open_buildCacheDisabled(t *testing.T) {
+	p := &project.Project{
+		Path: "_fixtures/twoFunctions",
+		Log:  log.Log,
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	assert.Equal(t, "", p.BuildCache)
+}
+
 func TestProject_LoadFunctions_loadAll(t *testing.T) {
 	p := &project.Project{
 		Path:            "_fixtures/twoFunctions",
@@ -52,6 +62,7 @@ func TestProject_LoadFunctions_loadSpecified(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
 	mockProvider.EXPECT().NewService(nil)
 
 	p := &project.Project{
@@ -71,6 +82,7 @@ func TestProject_LoadFunctions_onlyExisting(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
 	mockProvider.EXPECT().NewService(nil)
 
 	p := &project.Project{
@@ -90,6 +102,7 @@ func TestProject_LoadFunctions_noFunctionLoaded(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
 	mockProvider.EXPECT().NewService(nil).MaxTimes(0)
 
 	p := &project.Project{
@@ -108,6 +121,7 @@ func TestProject_LoadFunctionByPath_mergeEnvWithFunctionEnv(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
 	mockProvider.EXPECT().NewService(nil)
 
 	p := &project.Project{
@@ -122,10 +136,49 @@ func TestProject_LoadFunctionByPath_mergeEnvWithFunctionEnv(t *testing.T) {
 	assert.Equal(t, map[string]string{"PROJECT_ENV": "projectEnv", "FUNCTION_ENV": "functionEnv", "APEX_FUNCTION_NAME": "foo", "LAMBDA_FUNCTION_NAME": "envMerge_foo"}, p.Functions[0].Environment)
 }
 
+func TestProject_LoadFunctionByPath_environmentOverlays(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
+	mockProvider.EXPECT().NewService(nil)
+
+	p := &project.Project{
+		Path:            "_fixtures/envOverlay",
+		Environment:     "prod",
+		Log:             log.Log,
+		ServiceProvider: mockProvider,
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	assert.Equal(t, map[string]string{"PROJECT_ENV": "projectEnv"}, p.Config.Environment)
+	assert.Equal(t, []string{"subnet-prod"}, p.VPC.Subnets)
+	assert.Equal(t, []string{"sg-default"}, p.VPC.SecurityGroups)
+	assert.Equal(t, int64(512), p.Memory)
+
+	assert.NoError(t, p.LoadFunctions("foo"), "load")
+
+	fn := p.Functions[0]
+	assert.Equal(t, map[string]string{"FUNCTION_ENV": "functionProd", "APEX_FUNCTION_NAME": "foo", "LAMBDA_FUNCTION_NAME": "envOverlay_foo"}, fn.Environment)
+	assert.Equal(t, int64(512), fn.Memory)
+	assert.Equal(t, int64(10), fn.Timeout)
+}
+
+func TestProject_Open_missingEnvironment(t *testing.T) {
+	p := &project.Project{
+		Path:        "_fixtures/envOverlay",
+		Environment: "staging",
+		Log:         log.Log,
+	}
+
+	assert.Error(t, p.Open())
+}
+
 func TestProject_LoadFunctionByPath_overrideVpcWithFunctionVpc(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
 	mockProvider.EXPECT().NewService(nil).MaxTimes(2)
 
 	p := &project.Project{
@@ -151,6 +204,7 @@ func TestProject_LoadFunctionByPath_edgeFunction(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
 	mockProvider.EXPECT().NewService(aws.NewConfig().WithRegion("us-east-1"))
 
 	p := &project.Project{
@@ -167,6 +221,7 @@ func TestProject_LoadFunctionByPath_multiRegionFunction(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
 	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
 	gomock.InOrder(
 		mockProvider.EXPECT().NewService(aws.NewConfig().WithRegion("eu-west-2")),
 		mockProvider.EXPECT().NewService(nil),
@@ -187,3 +242,44 @@ func TestProject_LoadFunctionByPath_multiRegionFunction(t *testing.T) {
 	assert.Equal(t, "baz", p.Functions[1].Name)
 	assert.Equal(t, "foo", p.Functions[2].Name)
 }
+
+func TestProject_Validate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
+	mockProvider.EXPECT().NewService(nil)
+
+	p := &project.Project{
+		Path:            "_fixtures/invalidConfig",
+		Log:             log.Log,
+		ServiceProvider: mockProvider,
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	assert.NoError(t, p.LoadFunctions(), "load")
+
+	err := p.Validate(false)
+	assert.Equal(t, `function foo: functions/foo/function.json: timeout: must be between 1 and 900 seconds, got 1000
+function foo: functions/foo/function.json: handler: must be FILE.FUNCTION for nodejs22.x, got "index"
+function foo: project.json: role: must be an IAM role ARN, got "testrole"
+function foo: project.json: environment.AWS_REGION: is reserved by Lambda`, err.Error())
+}
+
+func TestProject_LoadFunctions_plugins(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
+	mockProvider.EXPECT().NewService(nil)
+
+	p := &project.Project{
+		Path:            "_fixtures/plugins",
+		Log:             log.Log,
+		ServiceProvider: mockProvider,
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	assert.NoError(t, p.LoadFunctions(), "load")
+	assert.Equal(t, []string{"inference", "nodejs", "shim"}, p.Functions[0].Plugins)
+}
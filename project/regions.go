@@ -0,0 +1,218 @@
+package project
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/tj/go-sync/semaphore"
+
+	"github.com/apex/apex/function"
+)
+
+// Region failure handling.
+const (
+	// RegionFailureStop stops starting functions or regions after the first failure.
+	RegionFailureStop = "stop"
+
+	// RegionFailureContinue attempts every function and region, reporting all failures.
+	RegionFailureContinue = "continue"
+)
+
+// Errors of the functions which failed.
+type Errors []error
+
+// Error implementation.
+func (e Errors) Error() string {
+	var lines []string
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+// combine returns nil, the only error or Errors, flattening nested Errors.
+func combine(errs []error) error {
+	var flat Errors
+	for _, err := range errs {
+		if e, ok := err.(Errors); ok {
+			flat = append(flat, e...)
+		} else {
+			flat = append(flat, err)
+		}
+	}
+
+	switch len(flat) {
+	case 0:
+		return nil
+	case 1:
+		return flat[0]
+	default:
+		return flat
+	}
+}
+
+// functionError returns `err` prefixed with the function name and region, or nil.
+func functionError(fn *function.Function, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if len(fn.Regions) > 0 && fn.Region != "" {
+		return fmt.Errorf("function %s in %s: %s", fn.Name, fn.Region, err)
+	}
+
+	return fmt.Errorf("function %s: %s", fn.Name, err)
+}
+
+// each calls `do` for 0 to n-1 concurrently, up to the project's concurrency.
+// No more calls are started after a failure unless the region failure handling
+// is to continue, in which case the errors of every call are returned.
+func (p *Project) each(n int, do func(i int) error) error {
+	return p.concurrently(n, p.RegionFailure == RegionFailureContinue, do)
+}
+
+// concurrently calls `do` for 0 to n-1, up to the project's concurrency,
+// stopping after the first failure unless `all` is true.
+func (p *Project) concurrently(n int, all bool, do func(i int) error) error {
+	sem := make(semaphore.Semaphore, p.Concurrency)
+
+	var mu sync.Mutex
+	var errs []error
+
+	for i := 0; i < n; i++ {
+		sem.Acquire()
+
+		mu.Lock()
+		stop := len(errs) > 0 && !all
+		mu.Unlock()
+
+		if stop {
+			sem.Release()
+			break
+		}
+
+		i := i
+
+		go func() {
+			defer sem.Release()
+
+			if err := do(i); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	sem.Wait()
+
+	if !all && len(errs) > 1 {
+		errs = errs[:1]
+	}
+
+	return combine(errs)
+}
+
+// groups returns the functions grouped by name, one function per region.
+func (p *Project) groups() [][]*function.Function {
+	var groups [][]*function.Function
+	index := make(map[string]int)
+
+	for _, fn := range p.Functions {
+		i, ok := index[fn.Name]
+		if !ok {
+			i = len(groups)
+			index[fn.Name] = i
+			groups = append(groups, nil)
+		}
+
+		groups[i] = append(groups[i], fn)
+	}
+
+	return groups
+}
+
+// packageGroups packages each function once for all of its regions, returning
+// the errors of every function so that no function is deployed when any fails.
+func (p *Project) packageGroups(groups [][]*function.Function) ([][]byte, error) {
+	zips := make([][]byte, len(groups))
+
+	err := p.concurrently(len(groups), true, func(i int) error {
+		fn := groups[i][0]
+
+		zip, err := fn.Package()
+
+		if problems, ok := err.(function.Problems); ok {
+			for j := range problems {
+				problems[j].File = p.origin(fn, problems[j].Field)
+			}
+			return problems
+		}
+
+		if err != nil {
+			return fmt.Errorf("function %s: %s", fn.Name, err)
+		}
+
+		zips[i] = zip
+		return nil
+	})
+
+	return zips, err
+}
+
+// deployGroup deploys the packaged `zip` of a function to each of its regions,
+// resolving the references to the functions it depends on.
+func (p *Project) deployGroup(fns []*function.Function, zip []byte) error {
+	return p.each(len(fns), func(i int) error {
+		if err := p.resolveReferences(fns[i]); err != nil {
+			return functionError(fns[i], err)
+		}
+
+		return functionError(fns[i], fns[i].DeployZip(zip))
+	})
+}
+
+// loadFunctionRegions returns the function in the ./functions/<name>
+// directory, once for each of its regions when specified.
+func (p *Project) loadFunctionRegions(name string) ([]*function.Function, error) {
+	path := filepath.Join(p.Path, functionsDir, name)
+
+	fn, err := p.openFunction(name, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fn.Regions) == 0 {
+		p.connect(fn)
+		return []*function.Function{fn}, nil
+	}
+
+	if fn.Edge {
+		return nil, errors.New("edge functions are deployed to us-east-1 and cannot specify regions")
+	}
+
+	if len(fn.Regions) > 1 && fn.S3Bucket != "" && !strings.Contains(fn.S3Bucket, function.BucketRegion) {
+		return nil, fmt.Errorf("s3Bucket must contain %s to use a bucket in each of the regions", function.BucketRegion)
+	}
+
+	var fns []*function.Function
+	for _, region := range fn.Regions {
+		fn, err := p.openFunction(name, path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "region %s", region)
+		}
+
+		fn.Region = region
+		fn.Log = fn.Log.WithField("region", region)
+		p.connect(fn)
+		fns = append(fns, fn)
+	}
+
+	return fns, nil
+}
@@ -0,0 +1,208 @@
+package project_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/apex/log"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/mock"
+	"github.com/apex/apex/mock/service"
+	"github.com/apex/apex/project"
+)
+
+func TestProject_LoadFunctions_regions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
+	gomock.InOrder(
+		mockProvider.EXPECT().NewService(aws.NewConfig().WithRegion("ap-southeast-2")),
+		mockProvider.EXPECT().NewService(aws.NewConfig().WithRegion("us-west-2")),
+		mockProvider.EXPECT().NewService(aws.NewConfig().WithRegion("eu-west-1")),
+	)
+
+	p := &project.Project{
+		Path:            "_fixtures/regions",
+		Log:             log.Log,
+		ServiceProvider: mockProvider,
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	assert.NoError(t, p.LoadFunctions(), "load")
+
+	assert.Equal(t, 3, len(p.Functions))
+	assert.Equal(t, "bar", p.Functions[0].Name)
+	assert.Equal(t, "ap-southeast-2", p.Functions[0].Region)
+	assert.Equal(t, "foo", p.Functions[1].Name)
+	assert.Equal(t, "us-west-2", p.Functions[1].Region)
+	assert.Equal(t, "foo", p.Functions[2].Name)
+	assert.Equal(t, "eu-west-1", p.Functions[2].Region)
+}
+
+// regionCloudWatch is a CloudWatch service of a region.
+type regionCloudWatch struct {
+	cloudwatchiface.CloudWatchAPI
+	region string
+}
+
+func TestProject_LoadFunctions_regionCanaryMetrics(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
+	mockProvider.EXPECT().NewService(gomock.Any()).AnyTimes()
+
+	west := &regionCloudWatch{region: "us-west-2"}
+	eu := &regionCloudWatch{region: "eu-west-1"}
+	mockProvider.EXPECT().NewCloudWatchService(aws.NewConfig().WithRegion("us-west-2")).Return(west)
+	mockProvider.EXPECT().NewCloudWatchService(aws.NewConfig().WithRegion("eu-west-1")).Return(eu)
+
+	p := &project.Project{
+		Path:            "_fixtures/regions",
+		Log:             log.Log,
+		ServiceProvider: mockProvider,
+		Canary:          &function.Canary{Weight: 0.1},
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	assert.NoError(t, p.LoadFunctions("foo"), "load")
+
+	assert.Equal(t, 2, len(p.Functions))
+	assert.Equal(t, west, p.Functions[0].Canary.Metrics)
+	assert.Equal(t, eu, p.Functions[1].Canary.Metrics)
+	assert.Nil(t, p.Canary.Metrics)
+}
+
+func TestProject_Diff_layerRegion(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
+	serviceMock := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	// the function and its layer both use the region of the function
+	mockProvider.EXPECT().NewService(aws.NewConfig().WithRegion("eu-west-2")).Return(serviceMock).Times(2)
+	serviceMock.EXPECT().ListLayerVersions(&lambda.ListLayerVersionsInput{
+		LayerName: aws.String("layerRegion_deps"),
+		MaxItems:  aws.Int64(1),
+	}).Return(nil, errors.New("boom"))
+
+	p := &project.Project{
+		Path:            "_fixtures/layerRegion",
+		Log:             log.Log,
+		ServiceProvider: mockProvider,
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	assert.NoError(t, p.LoadFunctions(), "load")
+
+	_, err := p.Diff()
+	assert.EqualError(t, err, "layer deps: boom")
+}
+
+func TestProject_LoadFunctions_regionBuckets(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
+	mockProvider.EXPECT().NewService(gomock.Any()).AnyTimes()
+	mockProvider.EXPECT().NewS3Service(aws.NewConfig().WithRegion("us-west-2"))
+	mockProvider.EXPECT().NewS3Service(aws.NewConfig().WithRegion("eu-west-1"))
+
+	p := &project.Project{
+		Path:            "_fixtures/regionBuckets",
+		Log:             log.Log,
+		ServiceProvider: mockProvider,
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	assert.NoError(t, p.LoadFunctions(), "load")
+
+	assert.Equal(t, 2, len(p.Functions))
+	assert.Equal(t, "deploys-us-west-2", p.Functions[0].S3Bucket)
+	assert.Equal(t, "deploys-eu-west-1", p.Functions[1].S3Bucket)
+}
+
+func TestProject_LoadFunctions_regionsSharedBucket(t *testing.T) {
+	p := &project.Project{
+		Path:            "_fixtures/regionBucket",
+		Log:             log.Log,
+		ServiceProvider: mock_service.NewMockProvideriface(nil),
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	assert.EqualError(t, p.LoadFunctions(), "loading foo: s3Bucket must contain {{.Region}} to use a bucket in each of the regions")
+}
+
+// loadRegions loads function foo in us-west-2 and eu-west-1 with the given services.
+func loadRegions(t *testing.T, mockCtrl *gomock.Controller, west, eu *mock_lambdaiface.MockLambdaAPI) *project.Project {
+	mockProvider := mock_service.NewMockProvideriface(mockCtrl)
+	mockProvider.EXPECT().NewCloudWatchEventsService(gomock.Any()).AnyTimes()
+	mockProvider.EXPECT().NewService(aws.NewConfig().WithRegion("us-west-2")).Return(west)
+	mockProvider.EXPECT().NewService(aws.NewConfig().WithRegion("eu-west-1")).Return(eu)
+
+	p := &project.Project{
+		Path:            "_fixtures/regions",
+		Log:             log.Log,
+		ServiceProvider: mockProvider,
+		Alias:           "current",
+	}
+
+	assert.NoError(t, p.Open(), "open")
+	assert.NoError(t, p.LoadFunctions("foo"), "load")
+	return p
+}
+
+func TestProject_RollbackVersion_regionFailureContinue(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	west := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+	eu := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	west.EXPECT().GetAlias(gomock.Any()).Return(&lambda.AliasConfiguration{FunctionVersion: aws.String("2")}, nil)
+	west.EXPECT().UpdateAlias(gomock.Any())
+	eu.EXPECT().GetAlias(gomock.Any()).Return(nil, errors.New("boom"))
+
+	p := loadRegions(t, mockCtrl, west, eu)
+
+	err := p.RollbackVersion("1")
+	assert.EqualError(t, err, "function foo in eu-west-1: boom")
+}
+
+func TestProject_RollbackVersion_regionFailureStop(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	west := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+	eu := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	west.EXPECT().GetAlias(gomock.Any()).Return(nil, errors.New("boom"))
+
+	p := loadRegions(t, mockCtrl, west, eu)
+	p.RegionFailure = project.RegionFailureStop
+	p.Concurrency = 1
+
+	err := p.RollbackVersion("1")
+	assert.EqualError(t, err, "function foo in us-west-2: boom")
+}
+
+func TestProject_RollbackVersion_regionFailureContinueAll(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	west := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+	eu := mock_lambdaiface.NewMockLambdaAPI(mockCtrl)
+
+	west.EXPECT().GetAlias(gomock.Any()).Return(nil, errors.New("boom"))
+	eu.EXPECT().GetAlias(gomock.Any()).Return(nil, errors.New("boom"))
+
+	p := loadRegions(t, mockCtrl, west, eu)
+
+	err := p.RollbackVersion("1")
+	assert.EqualError(t, err, "function foo in eu-west-1: boom\nfunction foo in us-west-2: boom")
+}
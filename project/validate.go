@@ -0,0 +1,128 @@
+package project
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/apex/apex/function"
+	"github.com/apex/apex/utils"
+)
+
+// index matches array indexes of field paths.
+var index = regexp.MustCompile(`\[\d+\]`)
+
+// Validate checks the configuration of every function against Lambda limits
+// without calling AWS. When `build` is true the functions are also built to
+// check the size of their zips. Problems are returned as function.Problems,
+// after checking the dependencies between functions.
+func (p *Project) Validate(build bool) error {
+	if _, err := p.dependencies(); err != nil {
+		return err
+	}
+
+	var problems function.Problems
+	seen := make(map[string]bool)
+
+	add := func(fn *function.Function, list function.Problems) {
+		for _, problem := range list {
+			problem.File = p.origin(fn, problem.Field)
+			if !seen[problem.String()] {
+				seen[problem.String()] = true
+				problems = append(problems, problem)
+			}
+		}
+	}
+
+	for _, fn := range p.Functions {
+		add(fn, fn.Validate())
+	}
+
+	if build {
+		for _, fns := range p.groups() {
+			fn := fns[0]
+
+			zip, err := fn.ZipBytes()
+			if err != nil {
+				return fmt.Errorf("function %s: %s", fn.Name, err)
+			}
+
+			add(fn, fn.ValidateZip(zip))
+
+			if err := fn.Clean(); err != nil {
+				return fmt.Errorf("function %s: %s", fn.Name, err)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return problems
+	}
+
+	return nil
+}
+
+// origin returns the path of the config file which sets `field` of `fn`,
+// relative to the project. Overlays take precedence over the files they
+// overlay, and function configs over project configs. The function's
+// directory is returned for fields which are not configured, such as its zip.
+func (p *Project) origin(fn *function.Function, field string) string {
+	var paths []string
+
+	if p.Environment != "" {
+		paths = append(paths, filepath.Join(fn.Path, fmt.Sprintf("function.%s.json", p.Environment)))
+	}
+
+	paths = append(paths, filepath.Join(fn.Path, "function.json"))
+
+	if p.Environment != "" {
+		paths = append(paths, filepath.Join(p.Path, fmt.Sprintf("project.%s.json", p.Environment)))
+	}
+
+	paths = append(paths, filepath.Join(p.Path, "project.json"))
+
+	keys := strings.Split(index.ReplaceAllString(field, ""), ".")
+
+	for _, path := range paths {
+		config, err := utils.ReadJSON(path)
+		if err != nil {
+			continue
+		}
+
+		if hasKey(config, keys) {
+			return p.relative(path)
+		}
+	}
+
+	return p.relative(fn.Path)
+}
+
+// relative returns `path` relative to the project, or as-is.
+func (p *Project) relative(path string) string {
+	if rel, err := filepath.Rel(p.Path, path); err == nil {
+		return rel
+	}
+
+	return path
+}
+
+// hasKey returns true if the nested `keys` are set in `config`. Keys
+// within arrays are not traversed, the array itself is considered set.
+func hasKey(config map[string]interface{}, keys []string) bool {
+	v, ok := config[keys[0]]
+	if !ok {
+		return false
+	}
+
+	if len(keys) == 1 {
+		return true
+	}
+
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return true
+	}
+
+	return hasKey(m, keys[1:])
+}
@@ -5,13 +5,28 @@ import (
 	"github.com/apex/apex/dryrun"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents/cloudwatcheventsiface"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 )
 
 // Provideriface is service factory
 type Provideriface interface {
 	NewService(cfg *aws.Config) lambdaiface.LambdaAPI
+	NewS3Service(cfg *aws.Config) s3iface.S3API
+	NewCloudWatchEventsService(cfg *aws.Config) cloudwatcheventsiface.CloudWatchEventsAPI
+	NewSSMService(cfg *aws.Config) ssmiface.SSMAPI
+	NewSecretsManagerService(cfg *aws.Config) secretsmanageriface.SecretsManagerAPI
+	NewCloudWatchService(cfg *aws.Config) cloudwatchiface.CloudWatchAPI
 }
 
 // Provider implements interface
@@ -38,3 +53,55 @@ func (p *Provider) NewService(cfg *aws.Config) lambdaiface.LambdaAPI {
 		return lambda.New(p.Session)
 	}
 }
+
+// NewS3Service returns S3 service with AWS config
+func (p *Provider) NewS3Service(cfg *aws.Config) s3iface.S3API {
+	if p.DryRun {
+		return dryrun.NewS3(p.Session)
+	} else if cfg != nil {
+		return s3.New(p.Session, cfg)
+	} else {
+		return s3.New(p.Session)
+	}
+}
+
+// NewCloudWatchEventsService returns CloudWatch Events service with AWS config
+func (p *Provider) NewCloudWatchEventsService(cfg *aws.Config) cloudwatcheventsiface.CloudWatchEventsAPI {
+	if p.DryRun {
+		return dryrun.NewCloudWatchEvents(p.Session)
+	} else if cfg != nil {
+		return cloudwatchevents.New(p.Session, cfg)
+	} else {
+		return cloudwatchevents.New(p.Session)
+	}
+}
+
+// NewSSMService returns SSM service with AWS config, dry-runs
+// use the real service as parameters are only read
+func (p *Provider) NewSSMService(cfg *aws.Config) ssmiface.SSMAPI {
+	if cfg != nil {
+		return ssm.New(p.Session, cfg)
+	}
+
+	return ssm.New(p.Session)
+}
+
+// NewSecretsManagerService returns Secrets Manager service with AWS config,
+// dry-runs use the real service as secrets are only read
+func (p *Provider) NewSecretsManagerService(cfg *aws.Config) secretsmanageriface.SecretsManagerAPI {
+	if cfg != nil {
+		return secretsmanager.New(p.Session, cfg)
+	}
+
+	return secretsmanager.New(p.Session)
+}
+
+// NewCloudWatchService returns CloudWatch service with AWS config,
+// dry-runs use the real service as metrics are only read
+func (p *Provider) NewCloudWatchService(cfg *aws.Config) cloudwatchiface.CloudWatchAPI {
+	if cfg != nil {
+		return cloudwatch.New(p.Session, cfg)
+	}
+
+	return cloudwatch.New(p.Session)
+}
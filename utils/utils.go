@@ -6,9 +6,11 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 
 	"github.com/Unknwon/goconfig"
@@ -135,30 +137,126 @@ func ParseEnv(env []string) (map[string]string, error) {
 
 // ProfileAndRegionFromConfig attempts to load the .profile setting from `environment`'s config.
 func ProfileAndRegionFromConfig(environment string) (string, string, error) {
-	configFile := "project.json"
+	paths := []string{"project.json"}
 
 	if environment != "" {
-		configFile = fmt.Sprintf("project.%s.json", environment)
+		paths = append(paths, fmt.Sprintf("project.%s.json", environment))
 	}
 
-	f, err := os.Open(configFile)
-	if err != nil {
-		return "", "", err
-	}
-	defer f.Close()
-
 	var v struct {
 		Profile string `json:"profile"`
 		Region  string `json:"region"`
 	}
 
-	if err := json.NewDecoder(f).Decode(&v); err != nil {
+	if _, err := LoadConfig(&v, paths...); err != nil {
 		return "", "", err
 	}
 
 	return v.Profile, v.Region, nil
 }
 
+// LoadConfig decodes the JSON objects in `paths` into `v`, each file being an
+// overlay deep-merged onto the previous ones and the current value of `v`,
+// which provides the defaults. Missing files are skipped, the paths of the
+// files loaded are returned.
+func LoadConfig(v interface{}, paths ...string) ([]string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := decodeObject(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	var loaded []string
+
+	for _, path := range paths {
+		overlay, err := ReadJSON(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		MergeJSON(config, overlay)
+		loaded = append(loaded, path)
+	}
+
+	b, err = json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// decode into the zero value so deleted map keys are not retained
+	rv := reflect.ValueOf(v).Elem()
+	rv.Set(reflect.Zero(rv.Type()))
+
+	return loaded, json.Unmarshal(b, v)
+}
+
+// ReadJSON returns the JSON object in the file at `path`.
+func ReadJSON(path string) (map[string]interface{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m, err := decodeObject(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filepath.Base(path), err)
+	}
+
+	return m, nil
+}
+
+// MergeJSON deep-merges the JSON object `overlay` onto `base`. Objects are
+// merged key-wise, other values replace those of `base`, and null values
+// delete the key from `base`.
+func MergeJSON(base, overlay map[string]interface{}) {
+	for k, v := range overlay {
+		if v == nil {
+			delete(base, k)
+			continue
+		}
+
+		src, ok := v.(map[string]interface{})
+		if !ok {
+			base[k] = v
+			continue
+		}
+
+		dst, ok := base[k].(map[string]interface{})
+		if !ok {
+			dst = make(map[string]interface{})
+			base[k] = dst
+		}
+
+		MergeJSON(dst, src)
+	}
+}
+
+// decodeObject decodes a JSON object from `r`, preserving numbers.
+func decodeObject(r io.Reader) (map[string]interface{}, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var m map[string]interface{}
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+
+	if m == nil {
+		m = make(map[string]interface{})
+	}
+
+	return m, nil
+}
+
 // AssumeRole uses STS to assume the given `role`.
 func AssumeRole(role string, config *aws.Config) (*aws.Config, error) {
 	stscreds := sts.New(session.New(config))
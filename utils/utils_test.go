@@ -48,3 +48,40 @@ func Test_ParseEnv_missingValue(t *testing.T) {
 	assert.Equal(t, `environment variable "bar" is missing a value`, err.Error())
 	assert.Nil(t, m)
 }
+
+func Test_MergeJSON(t *testing.T) {
+	base := map[string]interface{}{
+		"memory": 128,
+		"environment": map[string]interface{}{
+			"A": "a",
+			"B": "b",
+		},
+		"layers": []interface{}{"x"},
+	}
+
+	utils.MergeJSON(base, map[string]interface{}{
+		"memory": 512,
+		"environment": map[string]interface{}{
+			"B": nil,
+			"C": "c",
+		},
+		"layers": []interface{}{"y"},
+		"vpc":    map[string]interface{}{"subnets": []interface{}{"s"}},
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"memory": 512,
+		"environment": map[string]interface{}{
+			"A": "a",
+			"C": "c",
+		},
+		"layers": []interface{}{"y"},
+		"vpc":    map[string]interface{}{"subnets": []interface{}{"s"}},
+	}, base)
+}
+
+func Test_MergeJSON_delete(t *testing.T) {
+	base := map[string]interface{}{"zip": "build.zip", "memory": 128}
+	utils.MergeJSON(base, map[string]interface{}{"zip": nil})
+	assert.Equal(t, map[string]interface{}{"memory": 128}, base)
+}